@@ -0,0 +1,8 @@
+# List every Clerk application whose name starts with "acme-".
+data "clerk_applications" "acme" {
+  name_prefix = "acme-"
+}
+
+output "application_ids" {
+  value = data.clerk_applications.acme.applications[*].id
+}
@@ -0,0 +1,11 @@
+# List every organization in the development instance, newest first.
+data "clerk_organizations" "all" {
+  application_id        = clerk_application.my_app.id
+  environment           = "development"
+  order_by              = "-created_at"
+  include_members_count = true
+}
+
+output "organization_slugs" {
+  value = data.clerk_organizations.all.organizations[*].slug
+}
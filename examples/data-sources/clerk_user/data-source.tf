@@ -0,0 +1,10 @@
+# Look up a user by email address.
+data "clerk_user" "jane" {
+  application_id = clerk_application.my_app.id
+  environment    = "production"
+  email_address  = "jane@example.com"
+}
+
+output "jane_user_id" {
+  value = data.clerk_user.jane.id
+}
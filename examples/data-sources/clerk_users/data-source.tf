@@ -0,0 +1,10 @@
+# Look up several users by email address.
+data "clerk_users" "admins" {
+  application_id  = clerk_application.my_app.id
+  environment     = "production"
+  email_addresses = ["jane@example.com", "john@example.com"]
+}
+
+output "admin_user_ids" {
+  value = data.clerk_users.admins.users[*].id
+}
@@ -0,0 +1,11 @@
+ephemeral "clerk_instance_keys" "prod" {
+  application_id = clerk_application.example.id
+  environment    = "production"
+}
+
+# Store the secret key in AWS Secrets Manager without writing it to state.
+resource "aws_secretsmanager_secret_version" "clerk_secret_key" {
+  secret_id                = aws_secretsmanager_secret.clerk.id
+  secret_string_wo         = ephemeral.clerk_instance_keys.prod.secret_key
+  secret_string_wo_version = 1
+}
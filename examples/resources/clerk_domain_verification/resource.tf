@@ -0,0 +1,27 @@
+resource "clerk_application" "example" {
+  name              = "My Application"
+  domain            = "example.com"
+  environment_types = ["development", "production"]
+}
+
+resource "aws_route53_record" "clerk" {
+  for_each = { for r in clerk_application.example.dns_records : r.host => r }
+
+  zone_id = aws_route53_zone.main.zone_id
+  name    = each.value.host
+  type    = "CNAME"
+  ttl     = 300
+  records = [each.value.value]
+}
+
+# Wait until Clerk has verified the records and issued the SSL certificate.
+resource "clerk_domain_verification" "example" {
+  application_id = clerk_application.example.id
+  domain         = clerk_application.example.domain
+
+  timeouts {
+    create = "1h"
+  }
+
+  depends_on = [aws_route53_record.clerk]
+}
@@ -31,5 +31,6 @@ resource "clerk_environment" "prod" {
     enabled                 = true
     max_allowed_memberships = 25
     admin_delete_enabled    = true
+    creator_role            = "org:admin"
   }
 }
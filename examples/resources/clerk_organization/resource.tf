@@ -5,6 +5,15 @@ resource "clerk_organization" "basic" {
   name           = "Acme Corp"
 }
 
+# Create an organization that Terraform may destroy. Deletion protection is
+# enabled by default.
+resource "clerk_organization" "preview" {
+  application_id      = clerk_application.my_app.id
+  environment         = "development"
+  name                = "Preview"
+  deletion_protection = false
+}
+
 # Create an organization with a custom slug and membership limit.
 resource "clerk_organization" "with_options" {
   application_id          = clerk_application.my_app.id
@@ -14,5 +23,17 @@ resource "clerk_organization" "with_options" {
   max_allowed_memberships = 100
 }
 
+# Create an organization with metadata and a logo.
+resource "clerk_organization" "tenant" {
+  application_id = clerk_application.my_app.id
+  environment    = "development"
+  name           = "Tenant Inc"
+  logo_path      = "${path.module}/logos/tenant.png"
+
+  public_metadata = jsonencode({
+    plan = "pro"
+  })
+}
+
 # Import an existing organization using the composite ID format:
 #   terraform import clerk_organization.existing {application_id}/{environment}/{organization_id}
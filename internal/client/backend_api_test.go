@@ -17,7 +17,7 @@ func TestGetInstanceSettingsClient_Success(t *testing.T) {
 		t.Fatalf("unexpected error registering backend client: %v", err)
 	}
 
-	isClient, err := c.GetInstanceSettingsClient("app_1", "development")
+	isClient, err := c.GetInstanceSettingsClient(context.Background(), "app_1", "development")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -27,9 +27,12 @@ func TestGetInstanceSettingsClient_Success(t *testing.T) {
 }
 
 func TestGetInstanceSettingsClient_NotRegistered(t *testing.T) {
-	c := NewClerkClient("platform-key")
+	server := newNotFoundServer()
+	defer server.Close()
 
-	_, err := c.GetInstanceSettingsClient("app_unknown", "development")
+	c := newTestClient(server, "platform-key")
+
+	_, err := c.GetInstanceSettingsClient(context.Background(), "app_unknown", "development")
 	if err == nil {
 		t.Fatal("expected error for unregistered backend client")
 	}
@@ -71,6 +74,31 @@ func TestUpdateInstanceSettings(t *testing.T) {
 	}
 }
 
+func TestRegisterBackendClient_CustomURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/instance" {
+			t.Errorf("expected /v1/instance, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	c := NewClerkClient("platform-key")
+	c.BackendAPIURL = server.URL + "/v1"
+	if err := c.RegisterBackendClient("app_1", "development", "sk_test_dev"); err != nil {
+		t.Fatalf("unexpected error registering backend client: %v", err)
+	}
+
+	hibp := true
+	err := c.UpdateInstanceSettings(context.Background(), "app_1", "development", &instancesettings.UpdateParams{
+		HIBP: &hibp,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
 func TestUpdateInstanceSettings_Error(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
@@ -180,6 +208,7 @@ func newBackendTestClient(t *testing.T, server *httptest.Server, appID, environm
 	// Register the backend client with the secret key.
 	config := &clerk.ClientConfig{}
 	config.Key = clerk.String(secretKey)
+	config.HTTPClient = c.newHTTPClient(backendClientKey(appID, environment))
 	// Override the backend URL to point at our test server.
 	config.URL = clerk.String(server.URL + "/v1/")
 
@@ -1,11 +1,15 @@
 package client
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"sync"
+	"time"
 
 	"github.com/clerk/clerk-sdk-go/v2"
+	"golang.org/x/sync/singleflight"
+	"golang.org/x/time/rate"
 )
 
 // ClerkClient wraps Clerk API access for both the Platform API (workspace-level)
@@ -14,7 +18,8 @@ import (
 // The Platform API key is used for workspace-level operations like managing
 // applications. Backend API keys are registered per application/environment
 // and used for instance-level operations like managing organizations and
-// environment settings.
+// environment settings. Keys for applications that are not managed in the
+// current run are fetched lazily from the Platform API on first use.
 type ClerkClient struct {
 	// PlatformAPIKey is the workspace-level API key for the Clerk Platform API.
 	PlatformAPIKey string
@@ -22,20 +27,66 @@ type ClerkClient struct {
 	// PlatformHTTPClient is the HTTP client used for Platform API calls.
 	PlatformHTTPClient *http.Client
 
+	// UserAgent is sent as the User-Agent header on every Platform and Backend API request.
+	UserAgent string
+
+	// APIVersion pins the Clerk-API-Version header. When empty, the Platform API
+	// default and the Clerk SDK's version are used.
+	APIVersion string
+
+	// HTTPTimeout bounds each individual request attempt. Retries get a fresh
+	// timeout. Zero disables it.
+	HTTPTimeout time.Duration
+
+	// MaxRetries is the number of times a rate-limited or failed request is retried.
+	MaxRetries int
+
+	// RetryMaxWait is the upper bound on the wait between retries.
+	RetryMaxWait time.Duration
+
+	// RequestsPerSecond is the client-side rate limit applied separately to the
+	// Platform API key and to each Backend API instance. Zero disables it.
+	RequestsPerSecond float64
+
+	// limitersMu protects the limiters map.
+	limitersMu sync.Mutex
+
+	// limiters maps a Platform or Backend API key to its token-bucket limiter.
+	limiters map[string]*rate.Limiter
+
+	// PlatformAPIURL is the base URL of the Clerk Platform API.
+	PlatformAPIURL string
+
+	// BackendAPIURL is the base URL of the Clerk Backend API. When empty, the
+	// Clerk SDK default is used.
+	BackendAPIURL string
+
 	// mu protects the backendClients map.
 	mu sync.RWMutex
 
 	// backendClients maps "{app_id}/{environment}" to a configured Backend API client config.
 	backendClients map[string]*clerk.ClientConfig
+
+	// backendLookups deduplicates concurrent secret key lookups for the same application.
+	backendLookups singleflight.Group
 }
 
 // NewClerkClient creates a new ClerkClient with the given Platform API key.
+// The key may be empty when only Backend API clients are registered.
 func NewClerkClient(platformAPIKey string) *ClerkClient {
-	return &ClerkClient{
-		PlatformAPIKey:     platformAPIKey,
-		PlatformHTTPClient: &http.Client{},
-		backendClients:     make(map[string]*clerk.ClientConfig),
+	c := &ClerkClient{
+		PlatformAPIKey:    platformAPIKey,
+		PlatformAPIURL:    platformAPIBaseURL,
+		UserAgent:         UserAgent("dev", ""),
+		HTTPTimeout:       DefaultHTTPTimeout,
+		MaxRetries:        DefaultMaxRetries,
+		RetryMaxWait:      DefaultRetryMaxWait,
+		RequestsPerSecond: DefaultRequestsPerSecond,
+		limiters:          make(map[string]*rate.Limiter),
+		backendClients:    make(map[string]*clerk.ClientConfig),
 	}
+	c.PlatformHTTPClient = c.newHTTPClient(platformLimiterKey)
+	return c
 }
 
 // RegisterBackendClient registers a Backend API client for a specific
@@ -52,24 +103,79 @@ func (c *ClerkClient) RegisterBackendClient(appID, environment, secretKey string
 	key := backendClientKey(appID, environment)
 	config := &clerk.ClientConfig{}
 	config.Key = clerk.String(secretKey)
+	config.HTTPClient = c.newHTTPClient(key)
+	if c.BackendAPIURL != "" {
+		config.URL = clerk.String(c.BackendAPIURL)
+	}
 	c.backendClients[key] = config
 	return nil
 }
 
 // GetBackendConfig returns the Backend API client configuration for the given
-// application and environment. Returns an error if no client is registered.
-func (c *ClerkClient) GetBackendConfig(appID, environment string) (*clerk.ClientConfig, error) {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
+// application and environment. If no client is registered, the instance secret
+// keys are fetched from the Platform API and registered before giving up.
+func (c *ClerkClient) GetBackendConfig(ctx context.Context, appID, environment string) (*clerk.ClientConfig, error) {
+	if config, ok := c.lookupBackendConfig(appID, environment); ok {
+		return config, nil
+	}
 
-	key := backendClientKey(appID, environment)
-	config, ok := c.backendClients[key]
+	if err := c.resolveBackendClients(ctx, appID); err != nil {
+		return nil, fmt.Errorf("no backend client registered for application %q environment %q, and fetching its secret keys failed: %w", appID, environment, err)
+	}
+
+	config, ok := c.lookupBackendConfig(appID, environment)
 	if !ok {
 		return nil, fmt.Errorf("no backend client registered for application %q environment %q", appID, environment)
 	}
 	return config, nil
 }
 
+// lookupBackendConfig returns the registered Backend API client configuration, if any.
+func (c *ClerkClient) lookupBackendConfig(appID, environment string) (*clerk.ClientConfig, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	config, ok := c.backendClients[backendClientKey(appID, environment)]
+	return config, ok
+}
+
+// backendLookupTimeout bounds a shared secret key lookup, including retries.
+const backendLookupTimeout = 5 * time.Minute
+
+// resolveBackendClients fetches the application's secret keys from the Platform
+// API and registers a Backend API client for every instance. Concurrent calls
+// for the same application share a single Platform API request. The shared
+// request is detached from the caller's context so that one caller giving up
+// does not fail the others; each caller still stops waiting when its own
+// context is done.
+func (c *ClerkClient) resolveBackendClients(ctx context.Context, appID string) error {
+	results := c.backendLookups.DoChan(appID, func() (any, error) {
+		lookupCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), backendLookupTimeout)
+		defer cancel()
+
+		application, err := c.GetApplication(lookupCtx, appID, true)
+		if err != nil {
+			return nil, err
+		}
+		for _, inst := range application.Instances {
+			if inst.SecretKey == "" {
+				continue
+			}
+			if err := c.RegisterBackendClient(appID, inst.EnvironmentType, inst.SecretKey); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	})
+
+	select {
+	case result := <-results:
+		return result.Err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // backendClientKey returns the map key for a given app/environment pair.
 func backendClientKey(appID, environment string) string {
 	return appID + "/" + environment
@@ -0,0 +1,190 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGetBackendConfig_Registered(t *testing.T) {
+	c := NewClerkClient("platform-key")
+	if err := c.RegisterBackendClient("app_1", "development", "sk_test_dev"); err != nil {
+		t.Fatalf("unexpected error registering backend client: %v", err)
+	}
+
+	config, err := c.GetBackendConfig(context.Background(), "app_1", "development")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if *config.Key != "sk_test_dev" {
+		t.Errorf("expected sk_test_dev, got %s", *config.Key)
+	}
+}
+
+func TestGetBackendConfig_LazyResolution(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if r.URL.Path != "/v1/platform/applications/app_1" {
+			t.Errorf("expected /v1/platform/applications/app_1, got %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("include_secret_keys") != "true" {
+			t.Error("expected include_secret_keys=true query param")
+		}
+
+		// Give concurrent callers time to pile up behind the first lookup.
+		time.Sleep(50 * time.Millisecond)
+
+		resp := PlatformApplicationResponse{
+			ApplicationID: "app_1",
+			Instances: []PlatformApplicationInstance{
+				{InstanceID: "ins_dev", EnvironmentType: "development", PublishableKey: "pk_dev", SecretKey: "sk_dev"},
+				{InstanceID: "ins_prod", EnvironmentType: "production", PublishableKey: "pk_prod", SecretKey: "sk_prod"},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	c := newTestClient(server, "test-key")
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			config, err := c.GetBackendConfig(context.Background(), "app_1", "development")
+			if err != nil {
+				errs <- err
+				return
+			}
+			if *config.Key != "sk_dev" {
+				t.Errorf("expected sk_dev, got %s", *config.Key)
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("expected 1 Platform API call, got %d", calls.Load())
+	}
+
+	// The production instance was registered by the same lookup.
+	config, err := c.GetBackendConfig(context.Background(), "app_1", "production")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if *config.Key != "sk_prod" {
+		t.Errorf("expected sk_prod, got %s", *config.Key)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("expected cached production key, got %d Platform API calls", calls.Load())
+	}
+}
+
+func TestGetBackendConfig_LazyResolutionCallerCancelled(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		<-release
+		resp := PlatformApplicationResponse{
+			ApplicationID: "app_1",
+			Instances: []PlatformApplicationInstance{
+				{InstanceID: "ins_dev", EnvironmentType: "development", PublishableKey: "pk_dev", SecretKey: "sk_dev"},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	c := newTestClient(server, "test-key")
+
+	// The first caller starts the lookup and gives up while it is in flight.
+	ctx, cancel := context.WithCancel(context.Background())
+	first := make(chan error, 1)
+	go func() {
+		_, err := c.GetBackendConfig(ctx, "app_1", "development")
+		first <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	second := make(chan error, 1)
+	go func() {
+		_, err := c.GetBackendConfig(context.Background(), "app_1", "development")
+		second <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	cancel()
+	if err := <-first; !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled for the cancelled caller, got %v", err)
+	}
+
+	close(release)
+	if err := <-second; err != nil {
+		t.Fatalf("expected the other caller to succeed, got %v", err)
+	}
+}
+
+func TestGetBackendConfig_UnknownEnvironment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		resp := PlatformApplicationResponse{
+			ApplicationID: "app_1",
+			Instances: []PlatformApplicationInstance{
+				{InstanceID: "ins_dev", EnvironmentType: "development", PublishableKey: "pk_dev", SecretKey: "sk_dev"},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	c := newTestClient(server, "test-key")
+
+	_, err := c.GetBackendConfig(context.Background(), "app_1", "production")
+	if err == nil {
+		t.Fatal("expected error for environment without an instance")
+	}
+}
+
+func TestGetBackendConfig_BackendOnly(t *testing.T) {
+	c := NewClerkClient("")
+	if err := c.RegisterBackendClient("app_1", "production", "sk_live_prod"); err != nil {
+		t.Fatalf("unexpected error registering backend client: %v", err)
+	}
+
+	config, err := c.GetBackendConfig(context.Background(), "app_1", "production")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if *config.Key != "sk_live_prod" {
+		t.Errorf("expected sk_live_prod, got %s", *config.Key)
+	}
+
+	// Without a Platform API key, unregistered instances can't be resolved lazily.
+	_, err = c.GetBackendConfig(context.Background(), "app_1", "development")
+	if !errors.Is(err, ErrMissingPlatformAPIKey) {
+		t.Fatalf("expected ErrMissingPlatformAPIKey, got %v", err)
+	}
+}
+
+// newNotFoundServer returns a test server that answers every request with 404,
+// so lazy backend key resolution fails without reaching the real Clerk API.
+func newNotFoundServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"errors":[{"code":"resource_not_found","message":"not found"}]}`))
+	}))
+}
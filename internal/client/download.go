@@ -0,0 +1,41 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// MaxDownloadSize bounds the size of files fetched by Download.
+const MaxDownloadSize = 10 << 20
+
+// Download fetches the file at url, such as an organization logo. The request
+// is unauthenticated and bypasses the Clerk API transport chain, but is bounded
+// by the client's HTTP timeout.
+func (c *ClerkClient) Download(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+
+	httpClient := &http.Client{Timeout: c.HTTPTimeout}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("downloading %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("downloading %s: unexpected status %s", url, resp.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, MaxDownloadSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", url, err)
+	}
+	if len(body) > MaxDownloadSize {
+		return nil, fmt.Errorf("downloading %s: file is larger than %d bytes", url, MaxDownloadSize)
+	}
+	return body, nil
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestDownload(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "" {
+			t.Errorf("expected no auth header, got %s", r.Header.Get("Authorization"))
+		}
+		w.Write([]byte("PNGDATA"))
+	}))
+	defer server.Close()
+
+	c := NewClerkClient("platform-key")
+
+	body, err := c.Download(context.Background(), server.URL+"/logo.png")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(body) != "PNGDATA" {
+		t.Errorf("unexpected body %q", body)
+	}
+}
+
+func TestDownload_Errors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/large.png" {
+			w.Write(bytes.Repeat([]byte("x"), MaxDownloadSize+1))
+			return
+		}
+		http.NotFound(w, r)
+	}))
+	defer server.Close()
+
+	c := NewClerkClient("platform-key")
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"/missing.png", "404"},
+		{"/large.png", "larger than"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			_, err := c.Download(context.Background(), server.URL+tt.path)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
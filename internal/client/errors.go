@@ -0,0 +1,161 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/clerk/clerk-sdk-go/v2"
+)
+
+const (
+	// apiPlatform identifies errors returned by the Clerk Platform API.
+	apiPlatform = "platform"
+
+	// apiBackend identifies errors returned by the Clerk Backend API.
+	apiBackend = "backend"
+)
+
+// APIErrorDetail is a single entry of the errors array in a Clerk API error response.
+type APIErrorDetail struct {
+	Code        string `json:"code"`
+	Message     string `json:"message"`
+	LongMessage string `json:"long_message"`
+
+	// ParamName is the request parameter the error refers to, if any.
+	ParamName string `json:"-"`
+}
+
+// Description returns the most descriptive message available for the error.
+func (d APIErrorDetail) Description() string {
+	if d.LongMessage != "" {
+		return d.LongMessage
+	}
+	return d.Message
+}
+
+// APIError represents an error response from the Clerk Platform or Backend API.
+// Errors holds the decoded errors array; Body holds the raw response body when
+// it could not be decoded.
+type APIError struct {
+	API        string
+	StatusCode int
+	TraceID    string
+	Errors     []APIErrorDetail
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	var msg string
+	if len(e.Errors) == 0 {
+		msg = e.Body
+	} else {
+		parts := make([]string, 0, len(e.Errors))
+		for _, d := range e.Errors {
+			part := d.Description()
+			switch {
+			case d.Code != "" && d.ParamName != "":
+				part += fmt.Sprintf(" (code: %s, param: %s)", d.Code, d.ParamName)
+			case d.Code != "":
+				part += fmt.Sprintf(" (code: %s)", d.Code)
+			}
+			parts = append(parts, part)
+		}
+		msg = strings.Join(parts, "; ")
+	}
+	if e.TraceID != "" {
+		msg += fmt.Sprintf(" [trace ID: %s]", e.TraceID)
+	}
+	return fmt.Sprintf("clerk %s API error (status %d): %s", e.API, e.StatusCode, msg)
+}
+
+// HasCode reports whether any of the error details carries the given code.
+func (e *APIError) HasCode(code string) bool {
+	for _, d := range e.Errors {
+		if d.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// IsNotFound reports whether err is a Clerk API error for a missing resource.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.StatusCode == http.StatusNotFound || apiErr.HasCode("resource_not_found")
+}
+
+// rawAPIErrorDetail mirrors the wire format of an error detail, including its meta object.
+type rawAPIErrorDetail struct {
+	Code        string          `json:"code"`
+	Message     string          `json:"message"`
+	LongMessage string          `json:"long_message"`
+	Meta        json.RawMessage `json:"meta,omitempty"`
+}
+
+// toDetail converts the wire format into an APIErrorDetail, extracting meta.param_name.
+func (r rawAPIErrorDetail) toDetail() APIErrorDetail {
+	detail := APIErrorDetail{
+		Code:        r.Code,
+		Message:     r.Message,
+		LongMessage: r.LongMessage,
+	}
+	if len(r.Meta) > 0 {
+		var meta struct {
+			ParamName string `json:"param_name"`
+		}
+		if err := json.Unmarshal(r.Meta, &meta); err == nil {
+			detail.ParamName = meta.ParamName
+		}
+	}
+	return detail
+}
+
+// newPlatformAPIError decodes a non-2xx Platform API response into an APIError.
+func newPlatformAPIError(resp *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		API:        apiPlatform,
+		StatusCode: resp.StatusCode,
+		TraceID:    resp.Header.Get("Clerk-Trace-Id"),
+		Body:       string(body),
+	}
+
+	var decoded struct {
+		Errors []rawAPIErrorDetail `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &decoded); err == nil {
+		for _, raw := range decoded.Errors {
+			apiErr.Errors = append(apiErr.Errors, raw.toDetail())
+		}
+	}
+	return apiErr
+}
+
+// backendError converts an error returned by the Clerk SDK into an APIError
+// when it is a decoded Backend API error response. Other errors, including
+// nil, are returned unchanged.
+func backendError(err error) error {
+	var sdkErr *clerk.APIErrorResponse
+	if !errors.As(err, &sdkErr) {
+		return err
+	}
+
+	apiErr := &APIError{
+		API:        apiBackend,
+		StatusCode: sdkErr.HTTPStatusCode,
+		TraceID:    sdkErr.TraceID,
+	}
+	if sdkErr.Response != nil {
+		apiErr.Body = string(sdkErr.Response.RawJSON)
+	}
+	for _, e := range sdkErr.Errors {
+		raw := rawAPIErrorDetail{Code: e.Code, Message: e.Message, LongMessage: e.LongMessage, Meta: e.Meta}
+		apiErr.Errors = append(apiErr.Errors, raw.toDetail())
+	}
+	return apiErr
+}
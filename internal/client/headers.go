@@ -0,0 +1,39 @@
+package client
+
+import (
+	"net/http"
+)
+
+// userAgentProduct is the product token at the start of the User-Agent header.
+const userAgentProduct = "terraform-provider-clerk"
+
+// UserAgent returns the User-Agent sent on every Clerk API request, e.g.
+// "terraform-provider-clerk/1.2.0 terraform/1.9.5". The Terraform part is
+// omitted when the Terraform version is unknown.
+func UserAgent(providerVersion, terraformVersion string) string {
+	ua := userAgentProduct + "/" + providerVersion
+	if terraformVersion != "" {
+		ua += " terraform/" + terraformVersion
+	}
+	return ua
+}
+
+// headerTransport is an http.RoundTripper that sets the provider's User-Agent
+// and, when pinned, the Clerk-API-Version header on every request. It replaces
+// the values the Clerk SDK sets for Backend API calls, so both APIs identify
+// the provider the same way.
+type headerTransport struct {
+	base   http.RoundTripper
+	client *ClerkClient
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	if t.client.UserAgent != "" {
+		req.Header.Set("User-Agent", t.client.UserAgent)
+	}
+	if t.client.APIVersion != "" {
+		req.Header.Set("Clerk-API-Version", t.client.APIVersion)
+	}
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/clerk/clerk-sdk-go/v2/instancesettings"
+)
+
+func TestUserAgent(t *testing.T) {
+	if got, want := UserAgent("1.2.0", "1.9.5"), "terraform-provider-clerk/1.2.0 terraform/1.9.5"; got != want {
+		t.Errorf("UserAgent() = %q, want %q", got, want)
+	}
+	if got, want := UserAgent("dev", ""), "terraform-provider-clerk/dev"; got != want {
+		t.Errorf("UserAgent() = %q, want %q", got, want)
+	}
+}
+
+func TestPlatformRequest_Headers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("User-Agent"); got != "terraform-provider-clerk/1.2.0 terraform/1.9.5" {
+			t.Errorf("unexpected User-Agent: %q", got)
+		}
+		if got := r.Header.Get("Clerk-API-Version"); got != "2025-04-10" {
+			t.Errorf("unexpected Clerk-API-Version: %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"application_id":"app_123","instances":[]}`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(server, "test-key")
+	c.UserAgent = UserAgent("1.2.0", "1.9.5")
+	c.APIVersion = "2025-04-10"
+
+	if _, err := c.GetApplication(context.Background(), "app_123", false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestPlatformRequest_NoAPIVersionByDefault(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Clerk-API-Version"); got != "" {
+			t.Errorf("expected no Clerk-API-Version header, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"application_id":"app_123","instances":[]}`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(server, "test-key")
+	if _, err := c.GetApplication(context.Background(), "app_123", false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestBackendRequest_Headers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Values("User-Agent"); len(got) != 1 || got[0] != "terraform-provider-clerk/1.2.0 terraform/1.9.5" {
+			t.Errorf("unexpected User-Agent: %q", got)
+		}
+		if got := r.Header.Values("Clerk-API-Version"); len(got) != 1 || got[0] != "2025-04-10" {
+			t.Errorf("unexpected Clerk-API-Version: %q", got)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	c := newBackendTestClient(t, server, "app_1", "development", "sk_test_dev")
+	c.UserAgent = UserAgent("1.2.0", "1.9.5")
+	c.APIVersion = "2025-04-10"
+
+	hibp := true
+	err := c.UpdateInstanceSettings(context.Background(), "app_1", "development", &instancesettings.UpdateParams{
+		HIBP: &hibp,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,134 @@
+package client
+
+import (
+	"bytes"
+	"io"
+	"maps"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// redactedValue replaces secrets in logged requests and responses.
+const redactedValue = "[REDACTED]"
+
+// maxLoggedBodySize caps how much of a request or response body is logged.
+const maxLoggedBodySize = 64 * 1024
+
+var (
+	bearerTokenPattern = regexp.MustCompile(`(?i)(bearer\s+)[^\s"]+`)
+	secretKeyPattern   = regexp.MustCompile(`("[a-z_]*secret_key"\s*:\s*)"[^"]*"`)
+	skPrefixPattern    = regexp.MustCompile(`\bsk_[A-Za-z0-9_]+`)
+)
+
+// loggingTransport is an http.RoundTripper that logs every request sent to
+// Clerk. Method, URL, status and latency are logged at DEBUG; headers and
+// bodies at TRACE. Bearer tokens, secret_key fields and sk_ keys are redacted
+// before anything is written to the log.
+type loggingTransport struct {
+	base http.RoundTripper
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	reqBody, err := peekRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]any{
+		"method": req.Method,
+		"url":    redact(req.URL.Redacted()),
+	}
+	tflog.Trace(ctx, "Sending Clerk API request", mergeFields(fields, map[string]any{
+		"headers": redactHeaders(req.Header),
+		"body":    truncateBody(redact(string(reqBody))),
+	}))
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	fields["duration"] = time.Since(start).String()
+	if err != nil {
+		tflog.Debug(ctx, "Clerk API request failed", mergeFields(fields, map[string]any{
+			"error": redact(err.Error()),
+		}))
+		return nil, err
+	}
+
+	fields["status"] = resp.StatusCode
+	tflog.Debug(ctx, "Received Clerk API response", fields)
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+	tflog.Trace(ctx, "Clerk API response body", mergeFields(fields, map[string]any{
+		"headers": redactHeaders(resp.Header),
+		"body":    truncateBody(redact(string(respBody))),
+	}))
+
+	return resp, nil
+}
+
+// peekRequestBody returns a copy of the request body, leaving req readable.
+func peekRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+		return io.ReadAll(body)
+	}
+
+	data, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(data))
+	return data, nil
+}
+
+// redact masks bearer tokens, secret_key JSON fields and sk_ keys in s.
+func redact(s string) string {
+	s = bearerTokenPattern.ReplaceAllString(s, "${1}"+redactedValue)
+	s = secretKeyPattern.ReplaceAllString(s, `${1}"`+redactedValue+`"`)
+	return skPrefixPattern.ReplaceAllString(s, redactedValue)
+}
+
+// redactHeaders flattens headers for logging, masking credentials.
+func redactHeaders(header http.Header) map[string]string {
+	result := make(map[string]string, len(header))
+	for name, values := range header {
+		if name == "Authorization" || name == "Cookie" || name == "Set-Cookie" {
+			result[name] = redactedValue
+			continue
+		}
+		result[name] = redact(strings.Join(values, ", "))
+	}
+	return result
+}
+
+// truncateBody shortens body to maxLoggedBodySize. Bodies are redacted before
+// truncation so that a cut can't hide a secret from the redaction patterns.
+func truncateBody(body string) string {
+	if len(body) > maxLoggedBodySize {
+		return body[:maxLoggedBodySize] + "...(truncated)"
+	}
+	return body
+}
+
+func mergeFields(base, extra map[string]any) map[string]any {
+	merged := maps.Clone(base)
+	maps.Copy(merged, extra)
+	return merged
+}
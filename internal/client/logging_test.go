@@ -0,0 +1,94 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestRedact(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"bearer token", "Bearer ak_platform_123", "Bearer [REDACTED]"},
+		{"secret_key field", `{"secret_key":"abc","publishable_key":"pk_test_1"}`, `{"secret_key":"[REDACTED]","publishable_key":"pk_test_1"}`},
+		{"prefixed secret_key field", `{"dev_secret_key": "abc"}`, `{"dev_secret_key": "[REDACTED]"}`},
+		{"sk_ string", "key sk_live_AbC123 rejected", "key [REDACTED] rejected"},
+		{"no secrets", `{"name":"My App"}`, `{"name":"My App"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := redact(tt.input); got != tt.want {
+				t.Errorf("redact(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoggingTransport_RedactsSecrets(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"application_id":"app_123","instances":[{"instance_id":"ins_1","environment_type":"development","publishable_key":"pk_test_1","secret_key":"sk_test_topsecret"}]}`))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	c := newTestClient(server, "ak_platform_secret")
+	app, err := c.GetApplication(ctx, "app_123", true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The response body must still be readable after it has been logged.
+	if app.Instances[0].SecretKey != "sk_test_topsecret" {
+		t.Errorf("expected secret key to be decoded, got %q", app.Instances[0].SecretKey)
+	}
+
+	logs := output.String()
+	for _, secret := range []string{"ak_platform_secret", "sk_test_topsecret"} {
+		if strings.Contains(logs, secret) {
+			t.Errorf("log output contains secret %q:\n%s", secret, logs)
+		}
+	}
+	for _, want := range []string{"Received Clerk API response", `"status":200`, "/platform/applications/app_123", "pk_test_1"} {
+		if !strings.Contains(logs, want) {
+			t.Errorf("expected log output to contain %q:\n%s", want, logs)
+		}
+	}
+}
+
+func TestLoggingTransport_PreservesRequestBody(t *testing.T) {
+	var received string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var buf bytes.Buffer
+		buf.ReadFrom(r.Body)
+		received = buf.String()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"application_id":"app_123","instances":[]}`))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	c := newTestClient(server, "test-key")
+	if _, err := c.CreateApplication(ctx, PlatformCreateApplicationRequest{Name: "My App"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(received, `"name":"My App"`) {
+		t.Errorf("expected server to receive the request body, got %q", received)
+	}
+	if !strings.Contains(output.String(), `My App`) {
+		t.Errorf("expected request body to be logged:\n%s", output.String())
+	}
+}
@@ -0,0 +1,16 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// MetadataJSON returns the public or private metadata of a Clerk object as
+// compact JSON, or "{}" when it is absent.
+func MetadataJSON(raw json.RawMessage) string {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, raw); err != nil || buf.Len() == 0 || buf.String() == "null" {
+		return "{}"
+	}
+	return buf.String()
+}
@@ -0,0 +1,26 @@
+package client
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMetadataJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  json.RawMessage
+		want string
+	}{
+		{"absent", nil, "{}"},
+		{"null", json.RawMessage(`null`), "{}"},
+		{"invalid", json.RawMessage(`{`), "{}"},
+		{"compacted", json.RawMessage("{\n  \"plan\": \"pro\",\n  \"seats\": 5\n}"), `{"plan":"pro","seats":5}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MetadataJSON(tt.raw); got != tt.want {
+				t.Errorf("MetadataJSON() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
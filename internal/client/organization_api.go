@@ -1,6 +1,7 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 
@@ -10,44 +11,109 @@ import (
 
 // CreateOrganization creates an organization in the specified application/environment.
 func (c *ClerkClient) CreateOrganization(ctx context.Context, appID, environment string, params *organization.CreateParams) (*clerk.Organization, error) {
-	config, err := c.GetBackendConfig(appID, environment)
+	config, err := c.GetBackendConfig(ctx, appID, environment)
 	if err != nil {
 		return nil, fmt.Errorf("resolving backend client for %s/%s: %w", appID, environment, err)
 	}
 
 	orgClient := organization.NewClient(config)
-	return orgClient.Create(ctx, params)
+	org, err := orgClient.Create(ctx, params)
+	return org, backendError(err)
 }
 
-// GetOrganization fetches an organization by ID or slug.
+// GetOrganization fetches an organization by ID or slug, including its members count.
 func (c *ClerkClient) GetOrganization(ctx context.Context, appID, environment, idOrSlug string) (*clerk.Organization, error) {
-	config, err := c.GetBackendConfig(appID, environment)
+	config, err := c.GetBackendConfig(ctx, appID, environment)
 	if err != nil {
 		return nil, fmt.Errorf("resolving backend client for %s/%s: %w", appID, environment, err)
 	}
 
 	orgClient := organization.NewClient(config)
-	return orgClient.Get(ctx, idOrSlug)
+	org, err := orgClient.GetWithParams(ctx, idOrSlug, &organization.GetParams{
+		IncludeMembersCount: clerk.Bool(true),
+	})
+	return org, backendError(err)
 }
 
 // UpdateOrganization updates an organization by ID.
 func (c *ClerkClient) UpdateOrganization(ctx context.Context, appID, environment, id string, params *organization.UpdateParams) (*clerk.Organization, error) {
-	config, err := c.GetBackendConfig(appID, environment)
+	config, err := c.GetBackendConfig(ctx, appID, environment)
 	if err != nil {
 		return nil, fmt.Errorf("resolving backend client for %s/%s: %w", appID, environment, err)
 	}
 
 	orgClient := organization.NewClient(config)
-	return orgClient.Update(ctx, id, params)
+	org, err := orgClient.Update(ctx, id, params)
+	return org, backendError(err)
 }
 
 // DeleteOrganization deletes an organization by ID.
 func (c *ClerkClient) DeleteOrganization(ctx context.Context, appID, environment, id string) (*clerk.DeletedResource, error) {
-	config, err := c.GetBackendConfig(appID, environment)
+	config, err := c.GetBackendConfig(ctx, appID, environment)
 	if err != nil {
 		return nil, fmt.Errorf("resolving backend client for %s/%s: %w", appID, environment, err)
 	}
 
 	orgClient := organization.NewClient(config)
-	return orgClient.Delete(ctx, id)
+	deleted, err := orgClient.Delete(ctx, id)
+	return deleted, backendError(err)
+}
+
+// UpdateOrganizationLogo uploads logo as the organization's logo, replacing any existing one.
+func (c *ClerkClient) UpdateOrganizationLogo(ctx context.Context, appID, environment, id string, logo []byte) (*clerk.Organization, error) {
+	config, err := c.GetBackendConfig(ctx, appID, environment)
+	if err != nil {
+		return nil, fmt.Errorf("resolving backend client for %s/%s: %w", appID, environment, err)
+	}
+
+	orgClient := organization.NewClient(config)
+	org, err := orgClient.UpdateLogo(ctx, id, &organization.UpdateLogoParams{
+		File: logoFile{bytes.NewReader(logo)},
+	})
+	return org, backendError(err)
+}
+
+// DeleteOrganizationLogo removes the organization's logo.
+func (c *ClerkClient) DeleteOrganizationLogo(ctx context.Context, appID, environment, id string) (*clerk.Organization, error) {
+	config, err := c.GetBackendConfig(ctx, appID, environment)
+	if err != nil {
+		return nil, fmt.Errorf("resolving backend client for %s/%s: %w", appID, environment, err)
+	}
+
+	orgClient := organization.NewClient(config)
+	org, err := orgClient.DeleteLogo(ctx, id)
+	return org, backendError(err)
+}
+
+// logoFile adapts an in-memory logo to the multipart.File the SDK expects.
+type logoFile struct {
+	*bytes.Reader
+}
+
+func (logoFile) Close() error { return nil }
+
+// ListOrganizations returns every organization in the instance matching params,
+// following pagination until all organizations have been fetched. The Limit and
+// Offset of params are managed by this method.
+func (c *ClerkClient) ListOrganizations(ctx context.Context, appID, environment string, params *organization.ListParams) ([]*clerk.Organization, error) {
+	config, err := c.GetBackendConfig(ctx, appID, environment)
+	if err != nil {
+		return nil, fmt.Errorf("resolving backend client for %s/%s: %w", appID, environment, err)
+	}
+
+	orgClient := organization.NewClient(config)
+
+	if params == nil {
+		params = &organization.ListParams{}
+	}
+
+	return collectPages(backendPages(ctx, func(ctx context.Context, limit, offset int64) ([]*clerk.Organization, int64, error) {
+		params.Limit = clerk.Int64(limit)
+		params.Offset = clerk.Int64(offset)
+		list, err := orgClient.List(ctx, params)
+		if err != nil {
+			return nil, 0, err
+		}
+		return list.Organizations, list.TotalCount, nil
+	}))
 }
@@ -3,10 +3,13 @@ package client
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	"github.com/clerk/clerk-sdk-go/v2"
 	"github.com/clerk/clerk-sdk-go/v2/organization"
 )
 
@@ -169,7 +172,10 @@ func TestDeleteOrganization(t *testing.T) {
 }
 
 func TestCreateOrganization_NotRegistered(t *testing.T) {
-	c := NewClerkClient("platform-key")
+	server := newNotFoundServer()
+	defer server.Close()
+
+	c := newTestClient(server, "platform-key")
 
 	name := "Test"
 	_, err := c.CreateOrganization(context.Background(), "app_unknown", "development", &organization.CreateParams{
@@ -202,3 +208,155 @@ func TestGetOrganization_Error(t *testing.T) {
 		t.Fatal("expected error for 404 response")
 	}
 }
+
+func TestListOrganizations_Paginates(t *testing.T) {
+	const totalOrgs = 150
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Method != http.MethodGet {
+			t.Errorf("expected GET, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/organizations" {
+			t.Errorf("expected /v1/organizations, got %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("query") != "acme" || q.Get("order_by") != "-created_at" || q.Get("include_members_count") != "true" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+
+		var offset int
+		fmt.Sscanf(q.Get("offset"), "%d", &offset)
+
+		data := []map[string]any{}
+		for i := offset; i < totalOrgs && i < offset+100; i++ {
+			data = append(data, map[string]any{
+				"object":        "organization",
+				"id":            fmt.Sprintf("org_%d", i),
+				"members_count": i,
+			})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"data": data, "total_count": totalOrgs})
+	}))
+	defer server.Close()
+
+	c := newBackendTestClient(t, server, "app_1", "development", "sk_test_dev")
+
+	orgs, err := c.ListOrganizations(context.Background(), "app_1", "development", &organization.ListParams{
+		Query:               clerk.String("acme"),
+		OrderBy:             clerk.String("-created_at"),
+		IncludeMembersCount: clerk.Bool(true),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(orgs) != totalOrgs {
+		t.Errorf("expected %d organizations, got %d", totalOrgs, len(orgs))
+	}
+	if orgs[120].MembersCount == nil || *orgs[120].MembersCount != 120 {
+		t.Errorf("unexpected members_count: %v", orgs[120].MembersCount)
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+}
+
+func TestListOrganizations_CappedPageSize(t *testing.T) {
+	const totalOrgs, maxPage = 70, 30
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		var offset int
+		fmt.Sscanf(r.URL.Query().Get("offset"), "%d", &offset)
+
+		// The server returns fewer items than the requested limit.
+		data := []map[string]any{}
+		for i := offset; i < totalOrgs && i < offset+maxPage; i++ {
+			data = append(data, map[string]any{"object": "organization", "id": fmt.Sprintf("org_%d", i)})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"data": data, "total_count": totalOrgs})
+	}))
+	defer server.Close()
+
+	c := newBackendTestClient(t, server, "app_1", "development", "sk_test_dev")
+
+	orgs, err := c.ListOrganizations(context.Background(), "app_1", "development", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(orgs) != totalOrgs {
+		t.Fatalf("expected %d organizations, got %d", totalOrgs, len(orgs))
+	}
+	for i, org := range orgs {
+		if want := fmt.Sprintf("org_%d", i); org.ID != want {
+			t.Fatalf("expected %s at index %d, got %s", want, i, org.ID)
+		}
+	}
+	if requests != 3 {
+		t.Errorf("expected 3 requests, got %d", requests)
+	}
+}
+
+func TestGetOrganization_IncludesMembersCount(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("include_members_count") != "true" {
+			t.Errorf("expected include_members_count=true, got %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"object": "organization", "id": "org_test123", "members_count": 3})
+	}))
+	defer server.Close()
+
+	c := newBackendTestClient(t, server, "app_1", "development", "sk_test_dev")
+
+	org, err := c.GetOrganization(context.Background(), "app_1", "development", "org_test123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if org.MembersCount == nil || *org.MembersCount != 3 {
+		t.Errorf("expected members_count 3, got %v", org.MembersCount)
+	}
+}
+
+func TestUpdateOrganizationLogo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("expected PUT, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/organizations/org_test123/logo" {
+			t.Errorf("expected /v1/organizations/org_test123/logo, got %s", r.URL.Path)
+		}
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			t.Fatalf("reading file part: %v", err)
+		}
+		defer file.Close()
+		content, _ := io.ReadAll(file)
+		if string(content) != "PNGDATA" {
+			t.Errorf("unexpected file content %q", content)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"object":    "organization",
+			"id":        "org_test123",
+			"has_image": true,
+			"image_url": "https://img.clerk.com/logo",
+		})
+	}))
+	defer server.Close()
+
+	c := newBackendTestClient(t, server, "app_1", "development", "sk_test_dev")
+
+	org, err := c.UpdateOrganizationLogo(context.Background(), "app_1", "development", "org_test123", []byte("PNGDATA"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !org.HasImage {
+		t.Error("expected has_image to be true")
+	}
+}
@@ -0,0 +1,42 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clerk/clerk-sdk-go/v2"
+	"github.com/clerk/clerk-sdk-go/v2/organizationrole"
+)
+
+// ListOrganizationRoles returns every organization role defined on the instance,
+// following pagination until all roles have been fetched.
+func (c *ClerkClient) ListOrganizationRoles(ctx context.Context, appID, environment string) ([]*clerk.OrganizationRole, error) {
+	config, err := c.GetBackendConfig(ctx, appID, environment)
+	if err != nil {
+		return nil, fmt.Errorf("resolving backend client for %s/%s: %w", appID, environment, err)
+	}
+
+	roleClient := organizationrole.NewClient(config)
+
+	return collectPages(backendPages(ctx, func(ctx context.Context, limit, offset int64) ([]*clerk.OrganizationRole, int64, error) {
+		params := &organizationrole.ListParams{}
+		params.Limit = clerk.Int64(limit)
+		params.Offset = clerk.Int64(offset)
+		list, err := roleClient.List(ctx, params)
+		if err != nil {
+			return nil, 0, err
+		}
+		return list.OrganizationRoles, list.TotalCount, nil
+	}))
+}
+
+// FindOrganizationRole returns the role whose key or ID matches keyOrID, or nil
+// if no such role exists. Role keys look like "org:admin"; IDs look like "rol_...".
+func FindOrganizationRole(roles []*clerk.OrganizationRole, keyOrID string) *clerk.OrganizationRole {
+	for _, role := range roles {
+		if role.Key == keyOrID || role.ID == keyOrID {
+			return role
+		}
+	}
+	return nil
+}
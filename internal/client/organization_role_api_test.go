@@ -0,0 +1,75 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/clerk/clerk-sdk-go/v2"
+)
+
+func TestListOrganizationRoles_Paginates(t *testing.T) {
+	const totalRoles = 150
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Method != http.MethodGet {
+			t.Errorf("expected GET, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/organization_roles" {
+			t.Errorf("expected /v1/organization_roles, got %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("limit") != "100" {
+			t.Errorf("expected limit=100, got %s", r.URL.Query().Get("limit"))
+		}
+
+		var offset int
+		fmt.Sscanf(r.URL.Query().Get("offset"), "%d", &offset)
+
+		data := []map[string]any{}
+		for i := offset; i < totalRoles && i < offset+100; i++ {
+			data = append(data, map[string]any{
+				"object": "role",
+				"id":     fmt.Sprintf("rol_%d", i),
+				"key":    fmt.Sprintf("org:role_%d", i),
+			})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"data": data, "total_count": totalRoles})
+	}))
+	defer server.Close()
+
+	c := newBackendTestClient(t, server, "app_1", "development", "sk_test_dev")
+
+	roles, err := c.ListOrganizationRoles(context.Background(), "app_1", "development")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(roles) != totalRoles {
+		t.Errorf("expected %d roles, got %d", totalRoles, len(roles))
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+}
+
+func TestFindOrganizationRole(t *testing.T) {
+	roles := []*clerk.OrganizationRole{
+		{ID: "rol_admin", Key: "org:admin"},
+		{ID: "rol_member", Key: "org:member"},
+	}
+
+	if role := FindOrganizationRole(roles, "org:member"); role == nil || role.ID != "rol_member" {
+		t.Errorf("expected rol_member when looking up by key, got %v", role)
+	}
+	if role := FindOrganizationRole(roles, "rol_admin"); role == nil || role.Key != "org:admin" {
+		t.Errorf("expected org:admin when looking up by ID, got %v", role)
+	}
+	if role := FindOrganizationRole(roles, "org:unknown"); role != nil {
+		t.Errorf("expected nil for unknown role, got %v", role)
+	}
+}
@@ -0,0 +1,142 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"strconv"
+)
+
+// platformPageSize is the page size used when listing Platform API resources.
+const platformPageSize = 100
+
+// platformListPage is a page returned by a Platform API list endpoint.
+type platformListPage[T any] struct {
+	Data       []T    `json:"data"`
+	TotalCount *int64 `json:"total_count"`
+
+	// unpaginated is set when the endpoint responded with a bare JSON array,
+	// which holds every item regardless of limit and offset.
+	unpaginated bool
+}
+
+// platformPages iterates over every item of a Platform API list endpoint,
+// requesting pages of platformPageSize with limit and offset. The offset
+// advances by the number of items received, so servers that cap the page size
+// below the requested limit are handled. When the response reports
+// total_count, pages are requested until that many items have been fetched;
+// otherwise a short page ends the list. An empty page always ends it.
+// Endpoints that still respond with a bare JSON array are not paginated and
+// are requested once. Iteration stops at the first error.
+func platformPages[T any](ctx context.Context, c *ClerkClient, path string, query map[string]string) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		var fetched int64
+		for {
+			pageQuery := make(map[string]string, len(query)+2)
+			for k, v := range query {
+				pageQuery[k] = v
+			}
+			pageQuery["limit"] = strconv.Itoa(platformPageSize)
+			pageQuery["offset"] = strconv.FormatInt(fetched, 10)
+
+			resp, err := c.platformRequest(ctx, http.MethodGet, path, nil, pageQuery)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			page, err := decodePlatformListPage[T](resp)
+			if err != nil {
+				yield(zero, fmt.Errorf("unmarshaling list response: %w", err))
+				return
+			}
+
+			for _, item := range page.Data {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			fetched += int64(len(page.Data))
+
+			if !morePages(page.unpaginated, len(page.Data), platformPageSize, fetched, page.TotalCount) {
+				return
+			}
+		}
+	}
+}
+
+// morePages reports whether another page should be requested after a page of
+// n items, given the items fetched so far and the total count, if reported.
+func morePages(unpaginated bool, n, limit int, fetched int64, totalCount *int64) bool {
+	switch {
+	case unpaginated || n == 0:
+		return false
+	case totalCount != nil:
+		return fetched < *totalCount
+	default:
+		return n >= limit
+	}
+}
+
+// decodePlatformListPage decodes either a {"data": [...], "total_count": n}
+// envelope or a bare JSON array.
+func decodePlatformListPage[T any](body []byte) (platformListPage[T], error) {
+	var page platformListPage[T]
+	if trimmed := bytes.TrimSpace(body); len(trimmed) > 0 && trimmed[0] == '[' {
+		page.unpaginated = true
+		err := json.Unmarshal(trimmed, &page.Data)
+		return page, err
+	}
+	err := json.Unmarshal(body, &page)
+	return page, err
+}
+
+// backendPageSize is the page size used when listing Backend API resources.
+const backendPageSize int64 = 100
+
+// backendPages iterates over every item of a Backend API list endpoint. fetch
+// requests the page at the given limit and offset and returns its items and
+// the total count reported by the API. Pages follow the same rules as
+// platformPages: the offset advances by the number of items received, and
+// pages are requested until total_count items have been fetched or an empty
+// page is returned. Iteration stops at the first error.
+func backendPages[T any](ctx context.Context, fetch func(ctx context.Context, limit, offset int64) ([]T, int64, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		var fetched int64
+		for {
+			items, totalCount, err := fetch(ctx, backendPageSize, fetched)
+			if err != nil {
+				yield(zero, backendError(err))
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			fetched += int64(len(items))
+
+			if !morePages(false, len(items), int(backendPageSize), fetched, &totalCount) {
+				return
+			}
+		}
+	}
+}
+
+// collectPages gathers every item of a paginated iterator into a slice.
+func collectPages[T any](pages iter.Seq2[T, error]) ([]T, error) {
+	items := []T{}
+	for item, err := range pages {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
@@ -0,0 +1,191 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+// newPagedApplicationsServer serves total applications from /platform/applications
+// in a {"data", "total_count"} envelope, honouring limit and offset.
+func newPagedApplicationsServer(t *testing.T, total int, requests *int) *httptest.Server {
+	t.Helper()
+	return newCappedApplicationsServer(t, total, platformPageSize, requests)
+}
+
+// newCappedApplicationsServer is like newPagedApplicationsServer but returns at
+// most maxPage applications per page, whatever limit was requested.
+func newCappedApplicationsServer(t *testing.T, total, maxPage int, requests *int) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+		if err != nil {
+			t.Errorf("invalid limit %q", r.URL.Query().Get("limit"))
+		}
+		offset, err := strconv.Atoi(r.URL.Query().Get("offset"))
+		if err != nil {
+			t.Errorf("invalid offset %q", r.URL.Query().Get("offset"))
+		}
+
+		data := []PlatformApplicationResponse{}
+		for i := offset; i < total && i < offset+min(limit, maxPage); i++ {
+			data = append(data, PlatformApplicationResponse{ApplicationID: fmt.Sprintf("app_%d", i)})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"data": data, "total_count": total})
+	}))
+}
+
+func TestListApplications_Paginates(t *testing.T) {
+	var requests int
+	server := newPagedApplicationsServer(t, 2*platformPageSize+5, &requests)
+	defer server.Close()
+
+	c := newTestClient(server, "test-key")
+
+	apps, err := c.ListApplications(context.Background(), false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(apps) != 2*platformPageSize+5 {
+		t.Fatalf("expected %d applications, got %d", 2*platformPageSize+5, len(apps))
+	}
+	if apps[platformPageSize].ApplicationID != fmt.Sprintf("app_%d", platformPageSize) {
+		t.Errorf("unexpected application at page boundary: %s", apps[platformPageSize].ApplicationID)
+	}
+	if requests != 3 {
+		t.Errorf("expected 3 requests, got %d", requests)
+	}
+}
+
+func TestListApplications_StopsAtTotalCount(t *testing.T) {
+	var requests int
+	server := newPagedApplicationsServer(t, platformPageSize, &requests)
+	defer server.Close()
+
+	c := newTestClient(server, "test-key")
+
+	apps, err := c.ListApplications(context.Background(), false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(apps) != platformPageSize {
+		t.Fatalf("expected %d applications, got %d", platformPageSize, len(apps))
+	}
+	// A full page that reaches total_count must not trigger an empty follow-up request.
+	if requests != 1 {
+		t.Errorf("expected 1 request, got %d", requests)
+	}
+}
+
+func TestListApplications_BareArray(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("include_secret_keys") != "true" {
+			t.Error("expected include_secret_keys=true query param")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"application_id":"app_1","instances":[]},{"application_id":"app_2","instances":[]}]`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(server, "test-key")
+
+	apps, err := c.ListApplications(context.Background(), true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(apps) != 2 || apps[1].ApplicationID != "app_2" {
+		t.Errorf("unexpected applications: %+v", apps)
+	}
+}
+
+func TestListApplications_CappedPageSize(t *testing.T) {
+	var requests int
+	server := newCappedApplicationsServer(t, 70, 30, &requests)
+	defer server.Close()
+
+	c := newTestClient(server, "test-key")
+
+	apps, err := c.ListApplications(context.Background(), false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(apps) != 70 {
+		t.Fatalf("expected 70 applications, got %d", len(apps))
+	}
+	for i, app := range apps {
+		if want := fmt.Sprintf("app_%d", i); app.ApplicationID != want {
+			t.Fatalf("expected %s at index %d, got %s", want, i, app.ApplicationID)
+		}
+	}
+	if requests != 3 {
+		t.Errorf("expected 3 requests, got %d", requests)
+	}
+}
+
+func TestListApplications_BareArrayIgnoresPaging(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		data := []PlatformApplicationResponse{}
+		for i := range 2*platformPageSize + 5 {
+			data = append(data, PlatformApplicationResponse{ApplicationID: fmt.Sprintf("app_%d", i)})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(data)
+	}))
+	defer server.Close()
+
+	c := newTestClient(server, "test-key")
+
+	apps, err := c.ListApplications(context.Background(), false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(apps) != 2*platformPageSize+5 {
+		t.Fatalf("expected %d applications, got %d", 2*platformPageSize+5, len(apps))
+	}
+	if requests != 1 {
+		t.Errorf("expected 1 request, got %d", requests)
+	}
+}
+
+func TestApplications_StopsFetchingOnBreak(t *testing.T) {
+	var requests int
+	server := newPagedApplicationsServer(t, 3*platformPageSize, &requests)
+	defer server.Close()
+
+	c := newTestClient(server, "test-key")
+
+	for app, err := range c.Applications(context.Background(), false) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if app.ApplicationID == "app_1" {
+			break
+		}
+	}
+	if requests != 1 {
+		t.Errorf("expected 1 request, got %d", requests)
+	}
+}
+
+func TestApplications_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"errors":[{"code":"forbidden","message":"forbidden"}]}`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(server, "test-key")
+
+	if _, err := c.ListApplications(context.Background(), false); err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -3,12 +3,17 @@ package client
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"iter"
 	"net/http"
+	"strings"
 )
 
+// platformAPIBaseURL is the default base URL of the Clerk Platform API.
 const platformAPIBaseURL = "https://api.clerk.com/v1"
 
 // PlatformApplicationInstance represents an instance (dev/prod) within a Clerk application.
@@ -19,9 +24,33 @@ type PlatformApplicationInstance struct {
 	SecretKey       string `json:"secret_key,omitempty"`
 }
 
+// FrontendAPIURL returns the instance's Frontend API URL. Clerk encodes the
+// Frontend API host in the publishable key as base64("{host}$") after the
+// "pk_test_" or "pk_live_" prefix. Returns "" if the key can't be decoded.
+func (i PlatformApplicationInstance) FrontendAPIURL() string {
+	_, encoded, ok := strings.Cut(strings.TrimPrefix(i.PublishableKey, "pk_"), "_")
+	if !ok {
+		return ""
+	}
+	decoded, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(encoded, "="))
+	if err != nil {
+		return ""
+	}
+	host, ok := strings.CutSuffix(string(decoded), "$")
+	if !ok || host == "" {
+		return ""
+	}
+	return "https://" + host
+}
+
 // PlatformApplicationResponse is the response from the Platform API for application operations.
 type PlatformApplicationResponse struct {
 	ApplicationID string                        `json:"application_id"`
+	Name          string                        `json:"name,omitempty"`
+	LogoURL       string                        `json:"logo_url,omitempty"`
+	HomeURL       string                        `json:"home_url,omitempty"`
+	CreatedAt     int64                         `json:"created_at,omitempty"`
+	UpdatedAt     int64                         `json:"updated_at,omitempty"`
 	Instances     []PlatformApplicationInstance `json:"instances"`
 }
 
@@ -39,6 +68,13 @@ type PlatformUpdateApplicationRequest struct {
 	Name string `json:"name,omitempty"`
 }
 
+// PlatformCreateInstanceRequest is the request body for adding an instance to an existing application.
+type PlatformCreateInstanceRequest struct {
+	EnvironmentType string `json:"environment_type"`
+	Domain          string `json:"domain,omitempty"`
+	ProxyPath       string `json:"proxy_path,omitempty"`
+}
+
 // PlatformDeletedObjectResponse is the response from the Platform API for delete operations.
 type PlatformDeletedObjectResponse struct {
 	Deleted bool   `json:"deleted"`
@@ -46,16 +82,6 @@ type PlatformDeletedObjectResponse struct {
 	ID      string `json:"id"`
 }
 
-// PlatformAPIError represents an error response from the Clerk Platform API.
-type PlatformAPIError struct {
-	StatusCode int
-	Body       string
-}
-
-func (e *PlatformAPIError) Error() string {
-	return fmt.Sprintf("clerk platform API error (status %d): %s", e.StatusCode, e.Body)
-}
-
 // CreateApplication creates a new Clerk application via the Platform API.
 func (c *ClerkClient) CreateApplication(ctx context.Context, req PlatformCreateApplicationRequest) (*PlatformApplicationResponse, error) {
 	body, err := json.Marshal(req)
@@ -114,6 +140,27 @@ func (c *ClerkClient) UpdateApplication(ctx context.Context, applicationID strin
 	return &result, nil
 }
 
+// CreateApplicationInstance adds an instance of the given environment type to an
+// existing Clerk application via the Platform API. The response includes the
+// new instance's secret key.
+func (c *ClerkClient) CreateApplicationInstance(ctx context.Context, applicationID string, req PlatformCreateInstanceRequest) (*PlatformApplicationInstance, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling create instance request: %w", err)
+	}
+
+	resp, err := c.platformRequest(ctx, http.MethodPost, "/platform/applications/"+applicationID+"/instances", body, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result PlatformApplicationInstance
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("unmarshaling create instance response: %w", err)
+	}
+	return &result, nil
+}
+
 // DeleteApplication deletes a Clerk application by ID via the Platform API.
 func (c *ClerkClient) DeleteApplication(ctx context.Context, applicationID string) error {
 	resp, err := c.platformRequest(ctx, http.MethodDelete, "/platform/applications/"+applicationID, nil, nil)
@@ -131,28 +178,34 @@ func (c *ClerkClient) DeleteApplication(ctx context.Context, applicationID strin
 	return nil
 }
 
-// ListApplications lists all Clerk applications via the Platform API.
-func (c *ClerkClient) ListApplications(ctx context.Context, includeSecretKeys bool) ([]PlatformApplicationResponse, error) {
+// Applications iterates over every Clerk application in the workspace via the
+// Platform API, fetching further pages as the iteration proceeds.
+func (c *ClerkClient) Applications(ctx context.Context, includeSecretKeys bool) iter.Seq2[PlatformApplicationResponse, error] {
 	var query map[string]string
 	if includeSecretKeys {
 		query = map[string]string{"include_secret_keys": "true"}
 	}
+	return platformPages[PlatformApplicationResponse](ctx, c, "/platform/applications", query)
+}
 
-	resp, err := c.platformRequest(ctx, http.MethodGet, "/platform/applications", nil, query)
-	if err != nil {
-		return nil, err
-	}
-
-	var result []PlatformApplicationResponse
-	if err := json.Unmarshal(resp, &result); err != nil {
-		return nil, fmt.Errorf("unmarshaling list response: %w", err)
-	}
-	return result, nil
+// ListApplications lists all Clerk applications via the Platform API,
+// following pagination until every page has been fetched.
+func (c *ClerkClient) ListApplications(ctx context.Context, includeSecretKeys bool) ([]PlatformApplicationResponse, error) {
+	return collectPages(c.Applications(ctx, includeSecretKeys))
 }
 
+// ErrMissingPlatformAPIKey is returned by Platform API calls when the client was
+// configured without a Platform API key.
+var ErrMissingPlatformAPIKey = errors.New("a Clerk Platform API key is required for this operation: " +
+	"set platform_api_key in the provider configuration or the CLERK_PLATFORM_API_KEY environment variable")
+
 // platformRequest executes an authenticated HTTP request against the Clerk Platform API.
 func (c *ClerkClient) platformRequest(ctx context.Context, method, path string, body []byte, query map[string]string) ([]byte, error) {
-	url := platformAPIBaseURL + path
+	if c.PlatformAPIKey == "" {
+		return nil, ErrMissingPlatformAPIKey
+	}
+
+	url := strings.TrimRight(c.PlatformAPIURL, "/") + path
 
 	var bodyReader io.Reader
 	if body != nil {
@@ -189,10 +242,7 @@ func (c *ClerkClient) platformRequest(ctx context.Context, method, path string,
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, &PlatformAPIError{
-			StatusCode: resp.StatusCode,
-			Body:       string(respBody),
-		}
+		return nil, newPlatformAPIError(resp, respBody)
 	}
 
 	return respBody, nil
@@ -2,7 +2,9 @@ package client
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -98,6 +100,33 @@ func TestGetApplication_WithSecretKeys(t *testing.T) {
 	}
 }
 
+func TestGetApplication_Metadata(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"application_id":"app_123","name":"Renamed App","logo_url":"https://img.clerk.com/logo.png","home_url":"https://example.com","created_at":1700000000000,"updated_at":1700000001000,"instances":[]}`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(server, "test-key")
+
+	result, err := c.GetApplication(context.Background(), "app_123", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Name != "Renamed App" {
+		t.Errorf("expected name Renamed App, got %q", result.Name)
+	}
+	if result.LogoURL != "https://img.clerk.com/logo.png" {
+		t.Errorf("unexpected logo URL: %q", result.LogoURL)
+	}
+	if result.HomeURL != "https://example.com" {
+		t.Errorf("unexpected home URL: %q", result.HomeURL)
+	}
+	if result.CreatedAt != 1700000000000 || result.UpdatedAt != 1700000001000 {
+		t.Errorf("unexpected timestamps: created_at=%d updated_at=%d", result.CreatedAt, result.UpdatedAt)
+	}
+}
+
 func TestGetApplication_NotFound(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
 		w.WriteHeader(http.StatusNotFound)
@@ -111,9 +140,9 @@ func TestGetApplication_NotFound(t *testing.T) {
 	if err == nil {
 		t.Fatal("expected error for 404")
 	}
-	apiErr, ok := err.(*PlatformAPIError)
+	apiErr, ok := err.(*APIError)
 	if !ok {
-		t.Fatalf("expected *PlatformAPIError, got %T", err)
+		t.Fatalf("expected *APIError, got %T", err)
 	}
 	if apiErr.StatusCode != 404 {
 		t.Errorf("expected status 404, got %d", apiErr.StatusCode)
@@ -171,29 +200,82 @@ func TestUpdateApplication(t *testing.T) {
 	}
 }
 
+func TestCreateApplicationInstance(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/platform/applications/app_123/instances" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+
+		var req PlatformCreateInstanceRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		if req.EnvironmentType != "production" || req.Domain != "example.com" {
+			t.Errorf("unexpected request: %+v", req)
+		}
+
+		resp := PlatformApplicationInstance{
+			InstanceID:      "ins_prod",
+			EnvironmentType: "production",
+			PublishableKey:  "pk_live_xxx",
+			SecretKey:       "sk_live_xxx",
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	c := newTestClient(server, "test-key")
+
+	result, err := c.CreateApplicationInstance(context.Background(), "app_123", PlatformCreateInstanceRequest{
+		EnvironmentType: "production",
+		Domain:          "example.com",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.InstanceID != "ins_prod" || result.SecretKey != "sk_live_xxx" {
+		t.Errorf("unexpected instance: %+v", result)
+	}
+}
+
+func TestPlatformRequest_MissingAPIKey(t *testing.T) {
+	c := NewClerkClient("")
+
+	_, err := c.GetApplication(context.Background(), "app_123", false)
+	if !errors.Is(err, ErrMissingPlatformAPIKey) {
+		t.Fatalf("expected ErrMissingPlatformAPIKey, got %v", err)
+	}
+}
+
 // newTestClient creates a ClerkClient that points at the given test server.
 func newTestClient(server *httptest.Server, apiKey string) *ClerkClient {
 	c := NewClerkClient(apiKey)
-	// Override the base URL by replacing the platformRequest method's target.
-	// Since platformRequest uses the package-level const, we need a different approach:
-	// use a custom HTTP client that rewrites URLs.
-	c.PlatformHTTPClient = &http.Client{
-		Transport: &rewriteTransport{
-			base:    http.DefaultTransport,
-			baseURL: server.URL,
-		},
-	}
+	c.PlatformAPIURL = server.URL + "/v1"
 	return c
 }
 
-// rewriteTransport rewrites the request URL to point at the test server.
-type rewriteTransport struct {
-	base    http.RoundTripper
-	baseURL string
-}
-
-func (t *rewriteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	req.URL.Scheme = "http"
-	req.URL.Host = t.baseURL[len("http://"):]
-	return t.base.RoundTrip(req)
+func TestPlatformApplicationInstance_FrontendAPIURL(t *testing.T) {
+	tests := []struct {
+		name           string
+		publishableKey string
+		want           string
+	}{
+		{"development", "pk_test_" + base64.StdEncoding.EncodeToString([]byte("happy-cat-12.clerk.accounts.dev$")), "https://happy-cat-12.clerk.accounts.dev"},
+		{"production", "pk_live_" + base64.RawStdEncoding.EncodeToString([]byte("clerk.example.com$")), "https://clerk.example.com"},
+		{"missing terminator", "pk_test_" + base64.StdEncoding.EncodeToString([]byte("clerk.example.com")), ""},
+		{"not base64", "pk_test_!!!", ""},
+		{"empty", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inst := PlatformApplicationInstance{PublishableKey: tt.publishableKey}
+			if got := inst.FrontendAPIURL(); got != tt.want {
+				t.Errorf("FrontendAPIURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
 }
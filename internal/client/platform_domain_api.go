@@ -0,0 +1,102 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// PlatformDNSRecord is a DNS record Clerk requires for a production domain.
+type PlatformDNSRecord struct {
+	Host     string `json:"host"`
+	Value    string `json:"value"`
+	Required bool   `json:"required"`
+}
+
+// PlatformDomainResponse is the response from the Platform API for an
+// application's production domain.
+type PlatformDomainResponse struct {
+	ID                string              `json:"id"`
+	Name              string              `json:"name"`
+	ProxyPath         string              `json:"proxy_path,omitempty"`
+	FrontendAPIURL    string              `json:"frontend_api_url,omitempty"`
+	AccountsPortalURL string              `json:"accounts_portal_url,omitempty"`
+	CNAMETargets      []PlatformDNSRecord `json:"cname_targets"`
+}
+
+// Domain verification statuses reported by the Platform API.
+const (
+	DomainStatusComplete   = "complete"
+	DomainStatusIncomplete = "incomplete"
+)
+
+// PlatformDomainCheck is the verification status of one aspect of a production domain.
+type PlatformDomainCheck struct {
+	Status string `json:"status"`
+}
+
+// PlatformDomainStatusResponse is the response from the Platform API for the
+// verification status of an application's production domain.
+type PlatformDomainStatusResponse struct {
+	Status string              `json:"status"`
+	DNS    PlatformDomainCheck `json:"dns"`
+	SSL    PlatformDomainCheck `json:"ssl"`
+	Mail   PlatformDomainCheck `json:"mail"`
+}
+
+// PlatformUpdateDomainRequest is the request body for changing an application's production domain.
+type PlatformUpdateDomainRequest struct {
+	Name      *string `json:"name,omitempty"`
+	ProxyPath *string `json:"proxy_path,omitempty"`
+}
+
+// GetApplicationDomain retrieves the production domain of a Clerk application via the Platform API.
+func (c *ClerkClient) GetApplicationDomain(ctx context.Context, applicationID string) (*PlatformDomainResponse, error) {
+	resp, err := c.platformRequest(ctx, http.MethodGet, "/platform/applications/"+applicationID+"/domain", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result PlatformDomainResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("unmarshaling domain response: %w", err)
+	}
+	return &result, nil
+}
+
+// UpdateApplicationDomain changes the production domain or proxy path of a
+// Clerk application via the Platform API. The response includes the DNS
+// records required for the new domain.
+func (c *ClerkClient) UpdateApplicationDomain(ctx context.Context, applicationID string, req PlatformUpdateDomainRequest) (*PlatformDomainResponse, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling update domain request: %w", err)
+	}
+
+	resp, err := c.platformRequest(ctx, http.MethodPatch, "/platform/applications/"+applicationID+"/domain", body, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result PlatformDomainResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("unmarshaling update domain response: %w", err)
+	}
+	return &result, nil
+}
+
+// GetApplicationDomainStatus retrieves the DNS, SSL and mail verification status
+// of a Clerk application's production domain via the Platform API.
+func (c *ClerkClient) GetApplicationDomainStatus(ctx context.Context, applicationID string) (*PlatformDomainStatusResponse, error) {
+	resp, err := c.platformRequest(ctx, http.MethodGet, "/platform/applications/"+applicationID+"/domain/status", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result PlatformDomainStatusResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("unmarshaling domain status response: %w", err)
+	}
+	return &result, nil
+}
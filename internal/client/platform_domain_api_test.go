@@ -0,0 +1,106 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetApplicationDomain(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected GET, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/platform/applications/app_123/domain" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"id": "dmn_1",
+			"name": "example.com",
+			"cname_targets": [
+				{"host": "clerk.example.com", "value": "frontend-api.clerk.services", "required": true},
+				{"host": "clkmail.example.com", "value": "mail.abc.clerk.services", "required": false}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(server, "test-key")
+
+	domain, err := c.GetApplicationDomain(context.Background(), "app_123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if domain.Name != "example.com" {
+		t.Errorf("expected example.com, got %s", domain.Name)
+	}
+	if len(domain.CNAMETargets) != 2 {
+		t.Fatalf("expected 2 DNS records, got %d", len(domain.CNAMETargets))
+	}
+	if got := domain.CNAMETargets[0]; got.Host != "clerk.example.com" || !got.Required {
+		t.Errorf("unexpected DNS record: %+v", got)
+	}
+}
+
+func TestUpdateApplicationDomain(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("expected PATCH, got %s", r.Method)
+		}
+
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		if body["name"] != "new.example.com" {
+			t.Errorf("expected name new.example.com, got %v", body["name"])
+		}
+		if _, ok := body["proxy_path"]; ok {
+			t.Errorf("expected proxy_path to be omitted, got %v", body["proxy_path"])
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"dmn_1","name":"new.example.com","cname_targets":[]}`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(server, "test-key")
+
+	name := "new.example.com"
+	domain, err := c.UpdateApplicationDomain(context.Background(), "app_123", PlatformUpdateDomainRequest{Name: &name})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if domain.Name != "new.example.com" {
+		t.Errorf("expected new.example.com, got %s", domain.Name)
+	}
+}
+
+func TestGetApplicationDomainStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/platform/applications/app_123/domain/status" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"incomplete","dns":{"status":"complete"},"ssl":{"status":"incomplete"},"mail":{"status":"complete"}}`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(server, "test-key")
+
+	status, err := c.GetApplicationDomainStatus(context.Background(), "app_123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if status.Status != DomainStatusIncomplete {
+		t.Errorf("expected incomplete, got %s", status.Status)
+	}
+	if status.DNS.Status != DomainStatusComplete || status.SSL.Status != DomainStatusIncomplete {
+		t.Errorf("unexpected status: %+v", status)
+	}
+}
@@ -0,0 +1,75 @@
+package client
+
+import (
+	"net/http"
+
+	"golang.org/x/time/rate"
+)
+
+// DefaultRequestsPerSecond is the default client-side request rate per API key.
+const DefaultRequestsPerSecond = 10.0
+
+// platformLimiterKey identifies the limiter shared by all Platform API calls.
+// Backend API limiters are keyed by "{app_id}/{environment}".
+const platformLimiterKey = "platform"
+
+// rateLimitTransport is an http.RoundTripper that waits for a token from the
+// ClerkClient's limiter for key before sending each request. All resources
+// share the same limiters, so parallel applies stay under Clerk's rate limits.
+type rateLimitTransport struct {
+	base   http.RoundTripper
+	client *ClerkClient
+	key    string
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if limiter := t.client.limiter(t.key); limiter != nil {
+		if err := limiter.Wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+	return t.base.RoundTrip(req)
+}
+
+// limiter returns the token-bucket limiter for key, creating it on first use.
+// Returns nil when rate limiting is disabled.
+func (c *ClerkClient) limiter(key string) *rate.Limiter {
+	if c.RequestsPerSecond <= 0 {
+		return nil
+	}
+
+	c.limitersMu.Lock()
+	defer c.limitersMu.Unlock()
+
+	limiter, ok := c.limiters[key]
+	if !ok {
+		burst := max(1, int(c.RequestsPerSecond))
+		limiter = rate.NewLimiter(rate.Limit(c.RequestsPerSecond), burst)
+		c.limiters[key] = limiter
+	}
+	return limiter
+}
+
+// newHTTPClient returns an HTTP client whose requests are rate limited by the
+// limiter for key and retried on transient failures. Retries go through the
+// limiter too, so they count against the same budget, and every attempt is
+// logged individually and bounded by HTTPTimeout. Every request carries the
+// provider's identifying headers.
+func (c *ClerkClient) newHTTPClient(limiterKey string) *http.Client {
+	return &http.Client{
+		Transport: &headerTransport{
+			base: &retryTransport{
+				base: &rateLimitTransport{
+					base: &timeoutTransport{
+						base:   &loggingTransport{base: http.DefaultTransport},
+						client: c,
+					},
+					client: c,
+					key:    limiterKey,
+				},
+				client: c,
+			},
+			client: c,
+		},
+	}
+}
@@ -0,0 +1,51 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestLimiter_SharedPerKey(t *testing.T) {
+	c := NewClerkClient("platform-key")
+
+	if c.limiter(platformLimiterKey) != c.limiter(platformLimiterKey) {
+		t.Error("expected the same limiter for repeated lookups of the same key")
+	}
+	if c.limiter(backendClientKey("app_1", "development")) == c.limiter(backendClientKey("app_1", "production")) {
+		t.Error("expected separate limiters for separate backend instances")
+	}
+}
+
+func TestLimiter_Disabled(t *testing.T) {
+	c := NewClerkClient("platform-key")
+	c.RequestsPerSecond = 0
+
+	if c.limiter(platformLimiterKey) != nil {
+		t.Error("expected no limiter when rate limiting is disabled")
+	}
+}
+
+func TestPlatformRequest_RateLimited(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"application_id":"app_123","instances":[]}`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(server, "test-key")
+	c.RequestsPerSecond = 10
+
+	// The first 10 requests use the burst; the next 5 must wait ~100ms each.
+	start := time.Now()
+	for range 15 {
+		if _, err := c.GetApplication(context.Background(), "app_123", false); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 400*time.Millisecond {
+		t.Errorf("expected requests to be throttled, took %s", elapsed)
+	}
+}
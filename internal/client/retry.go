@@ -0,0 +1,140 @@
+package client
+
+import (
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// DefaultMaxRetries is the default number of times a failed request is retried.
+	DefaultMaxRetries = 3
+
+	// DefaultRetryMaxWait is the default upper bound on the wait between retries.
+	DefaultRetryMaxWait = 30 * time.Second
+
+	// retryMinWait is the base wait for the first retry; it doubles on each attempt.
+	retryMinWait = 500 * time.Millisecond
+)
+
+// retryTransport is an http.RoundTripper that retries rate-limited (429) and
+// transient (5xx, network) failures with exponential backoff and jitter.
+// Requests with non-idempotent methods are only retried on 429, since Clerk
+// has not processed them. Retry settings are read from the owning ClerkClient
+// on every request so that they can be changed after construction.
+type retryTransport struct {
+	base   http.RoundTripper
+	client *ClerkClient
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	for attempt := 0; ; attempt++ {
+		resp, err := t.base.RoundTrip(req)
+		if attempt >= t.client.MaxRetries || !shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		// Rewind the body for the next attempt. Without GetBody the body can't be replayed.
+		if req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				return resp, err
+			}
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return resp, err
+			}
+			req = req.Clone(ctx)
+			req.Body = body
+		}
+
+		wait := retryWait(attempt, resp, t.client.RetryMaxWait)
+		fields := map[string]any{
+			"method":  req.Method,
+			"url":     req.URL.Redacted(),
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+		}
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["status"] = resp.StatusCode
+			// Drain and close the body so the connection can be reused.
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		tflog.Warn(ctx, "Retrying Clerk API request", fields)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// shouldRetry reports whether a request should be retried given its outcome.
+func shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+	if err != nil {
+		return isIdempotent(req.Method)
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotent(req.Method)
+	}
+	return false
+}
+
+// isIdempotent reports whether repeating a request with the given method is safe.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryWait returns how long to wait before the next attempt. A Retry-After
+// header takes precedence; otherwise the wait grows exponentially with jitter.
+// The result never exceeds maxWait.
+func retryWait(attempt int, resp *http.Response, maxWait time.Duration) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return min(wait, maxWait)
+		}
+	}
+
+	backoff := retryMinWait << attempt
+	if backoff <= 0 || backoff > maxWait {
+		backoff = maxWait
+	}
+	// Equal jitter: wait somewhere between half and the full backoff.
+	half := backoff / 2
+	return half + rand.N(half+1)
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return max(time.Until(at), 0), true
+	}
+	return 0, false
+}
@@ -0,0 +1,142 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/clerk/clerk-sdk-go/v2/organization"
+)
+
+func TestPlatformRequest_RetriesRateLimit(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		// The request body must be replayed on retry.
+		var req PlatformCreateApplicationRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		if req.Name != "test-app" {
+			t.Errorf("expected name test-app, got %s", req.Name)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(PlatformApplicationResponse{ApplicationID: "app_123"})
+	}))
+	defer server.Close()
+
+	c := newTestClient(server, "test-key")
+
+	result, err := c.CreateApplication(context.Background(), PlatformCreateApplicationRequest{Name: "test-app"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ApplicationID != "app_123" {
+		t.Errorf("expected app_123, got %s", result.ApplicationID)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("expected 2 calls, got %d", calls.Load())
+	}
+}
+
+func TestPlatformRequest_RetriesServerErrorForIdempotentMethods(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	c := newTestClient(server, "test-key")
+	c.MaxRetries = 2
+	c.RetryMaxWait = time.Millisecond
+
+	_, err := c.GetApplication(context.Background(), "app_123", false)
+	apiErr, ok := err.(*APIError)
+	if !ok {
+		t.Fatalf("expected *APIError, got %T", err)
+	}
+	if apiErr.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("expected status 503, got %d", apiErr.StatusCode)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("expected 3 calls (1 + 2 retries), got %d", calls.Load())
+	}
+}
+
+func TestPlatformRequest_NoRetryOnServerErrorForPost(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	c := newTestClient(server, "test-key")
+	c.RetryMaxWait = time.Millisecond
+
+	_, err := c.CreateApplication(context.Background(), PlatformCreateApplicationRequest{Name: "test-app"})
+	if err == nil {
+		t.Fatal("expected error for 500 response")
+	}
+	if calls.Load() != 1 {
+		t.Errorf("expected 1 call, got %d", calls.Load())
+	}
+}
+
+func TestBackendRequest_RetriesRateLimit(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"object": "organization", "id": "org_test123"})
+	}))
+	defer server.Close()
+
+	c := newBackendTestClient(t, server, "app_1", "development", "sk_test_dev")
+
+	name := "Acme Corp"
+	result, err := c.CreateOrganization(context.Background(), "app_1", "development", &organization.CreateParams{Name: &name})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ID != "org_test123" {
+		t.Errorf("expected org_test123, got %s", result.ID)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("expected 2 calls, got %d", calls.Load())
+	}
+}
+
+func TestRetryWait(t *testing.T) {
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("Retry-After", "7")
+	if wait := retryWait(0, resp, time.Minute); wait != 7*time.Second {
+		t.Errorf("expected Retry-After of 7s to be honoured, got %s", wait)
+	}
+	if wait := retryWait(0, resp, 2*time.Second); wait != 2*time.Second {
+		t.Errorf("expected Retry-After to be capped at 2s, got %s", wait)
+	}
+
+	for attempt := range 5 {
+		backoff := min(retryMinWait<<attempt, 5*time.Second)
+		wait := retryWait(attempt, nil, 5*time.Second)
+		if wait < backoff/2 || wait > backoff {
+			t.Errorf("attempt %d: expected wait in [%s, %s], got %s", attempt, backoff/2, backoff, wait)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+// DefaultHTTPTimeout is the default time limit for a single HTTP request attempt.
+const DefaultHTTPTimeout = 60 * time.Second
+
+// timeoutTransport is an http.RoundTripper that bounds each request attempt by
+// the ClerkClient's HTTPTimeout. It sits below the retry transport, so a hung
+// attempt is abandoned and retried instead of consuming the whole operation's
+// deadline. The timeout covers reading the response body.
+type timeoutTransport struct {
+	base   http.RoundTripper
+	client *ClerkClient
+}
+
+func (t *timeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.client.HTTPTimeout <= 0 {
+		return t.base.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), t.client.HTTPTimeout)
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// cancelOnCloseBody releases the attempt's context once the body is closed.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestPlatformRequest_TimeoutAppliesPerAttempt(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			// Hang until the client gives up on this attempt.
+			<-r.Context().Done()
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"application_id":"app_123","instances":[]}`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(server, "test-key")
+	c.HTTPTimeout = 50 * time.Millisecond
+	c.RetryMaxWait = time.Millisecond
+
+	result, err := c.GetApplication(context.Background(), "app_123", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ApplicationID != "app_123" {
+		t.Errorf("expected app_123, got %s", result.ApplicationID)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("expected 2 calls, got %d", calls.Load())
+	}
+}
+
+func TestPlatformRequest_Timeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	c := newTestClient(server, "test-key")
+	c.HTTPTimeout = 50 * time.Millisecond
+	c.MaxRetries = 0
+
+	_, err := c.GetApplication(context.Background(), "app_123", false)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clerk/clerk-sdk-go/v2"
+	"github.com/clerk/clerk-sdk-go/v2/user"
+)
+
+// GetUser fetches a user by ID.
+func (c *ClerkClient) GetUser(ctx context.Context, appID, environment, id string) (*clerk.User, error) {
+	config, err := c.GetBackendConfig(ctx, appID, environment)
+	if err != nil {
+		return nil, fmt.Errorf("resolving backend client for %s/%s: %w", appID, environment, err)
+	}
+
+	userClient := user.NewClient(config)
+	u, err := userClient.Get(ctx, id)
+	return u, backendError(err)
+}
+
+// ListUsers returns every user in the instance matching params, following
+// pagination until all users have been fetched. The Limit and Offset of params
+// are managed by this method.
+func (c *ClerkClient) ListUsers(ctx context.Context, appID, environment string, params *user.ListParams) ([]*clerk.User, error) {
+	config, err := c.GetBackendConfig(ctx, appID, environment)
+	if err != nil {
+		return nil, fmt.Errorf("resolving backend client for %s/%s: %w", appID, environment, err)
+	}
+
+	userClient := user.NewClient(config)
+
+	if params == nil {
+		params = &user.ListParams{}
+	}
+
+	return collectPages(backendPages(ctx, func(ctx context.Context, limit, offset int64) ([]*clerk.User, int64, error) {
+		params.Limit = clerk.Int64(limit)
+		params.Offset = clerk.Int64(offset)
+		list, err := userClient.List(ctx, params)
+		if err != nil {
+			return nil, 0, err
+		}
+		return list.Users, list.TotalCount, nil
+	}))
+}
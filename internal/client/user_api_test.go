@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/clerk/clerk-sdk-go/v2/user"
+)
+
+func TestGetUser(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected GET, got %s", r.Method)
+		}
+		if r.URL.Path != "/v1/users/user_123" {
+			t.Errorf("expected /v1/users/user_123, got %s", r.URL.Path)
+		}
+		if r.Header.Get("Authorization") != "Bearer sk_test_dev" {
+			t.Errorf("unexpected auth header: %s", r.Header.Get("Authorization"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"object":   "user",
+			"id":       "user_123",
+			"username": "jdoe",
+		})
+	}))
+	defer server.Close()
+
+	c := newBackendTestClient(t, server, "app_1", "development", "sk_test_dev")
+
+	result, err := c.GetUser(context.Background(), "app_1", "development", "user_123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ID != "user_123" || result.Username == nil || *result.Username != "jdoe" {
+		t.Errorf("unexpected user: %+v", result)
+	}
+}
+
+func TestListUsers_Paginates(t *testing.T) {
+	const totalUsers = 150
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("email_address") != "jdoe@example.com" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+
+		// The SDK fetches the total from a separate count endpoint.
+		if r.URL.Path == "/v1/users/count" {
+			json.NewEncoder(w).Encode(map[string]any{"object": "total_count", "total_count": totalUsers})
+			return
+		}
+		if r.URL.Path != "/v1/users" {
+			t.Errorf("expected /v1/users, got %s", r.URL.Path)
+		}
+		requests++
+
+		var offset int
+		fmt.Sscanf(q.Get("offset"), "%d", &offset)
+
+		data := []map[string]any{}
+		for i := offset; i < totalUsers && i < offset+100; i++ {
+			data = append(data, map[string]any{"object": "user", "id": fmt.Sprintf("user_%d", i)})
+		}
+		json.NewEncoder(w).Encode(data)
+	}))
+	defer server.Close()
+
+	c := newBackendTestClient(t, server, "app_1", "development", "sk_test_dev")
+
+	users, err := c.ListUsers(context.Background(), "app_1", "development", &user.ListParams{
+		EmailAddresses: []string{"jdoe@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(users) != totalUsers {
+		t.Errorf("expected %d users, got %d", totalUsers, len(users))
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 list requests, got %d", requests)
+	}
+}
@@ -3,11 +3,18 @@ package datasources
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/makolabsai/terraform-provider-clerk/internal/client"
+	"github.com/makolabsai/terraform-provider-clerk/internal/diagnostics"
 )
 
 var (
@@ -22,12 +29,32 @@ type ApplicationDataSource struct {
 // ApplicationDataSourceModel describes the Terraform data source model.
 type ApplicationDataSourceModel struct {
 	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	LogoURL            types.String `tfsdk:"logo_url"`
+	HomeURL            types.String `tfsdk:"home_url"`
+	CreatedAt          types.Int64  `tfsdk:"created_at"`
 	DevInstanceID      types.String `tfsdk:"dev_instance_id"`
 	DevSecretKey       types.String `tfsdk:"dev_secret_key"`
 	DevPublishableKey  types.String `tfsdk:"dev_publishable_key"`
 	ProdInstanceID     types.String `tfsdk:"prod_instance_id"`
 	ProdSecretKey      types.String `tfsdk:"prod_secret_key"`
 	ProdPublishableKey types.String `tfsdk:"prod_publishable_key"`
+	Instances          types.Map    `tfsdk:"instances"`
+}
+
+// ApplicationInstanceModel describes an entry of the instances map.
+type ApplicationInstanceModel struct {
+	InstanceID     types.String `tfsdk:"instance_id"`
+	PublishableKey types.String `tfsdk:"publishable_key"`
+	SecretKey      types.String `tfsdk:"secret_key"`
+	FrontendAPIURL types.String `tfsdk:"frontend_api_url"`
+}
+
+var applicationInstanceAttrTypes = map[string]attr.Type{
+	"instance_id":      types.StringType,
+	"publishable_key":  types.StringType,
+	"secret_key":       types.StringType,
+	"frontend_api_url": types.StringType,
 }
 
 func NewApplicationDataSource() datasource.DataSource {
@@ -40,11 +67,33 @@ func (d *ApplicationDataSource) Metadata(_ context.Context, req datasource.Metad
 
 func (d *ApplicationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Look up an existing Clerk application by its ID.",
+		Description: "Look up an existing Clerk application by its ID or name.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "The unique identifier of the Clerk application.",
-				Required:    true,
+				Description: "The unique identifier of the Clerk application. Exactly one of id or name must be specified.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the application. Exactly one of id or name must be specified; " +
+					"the lookup fails unless exactly one application has this name.",
+				Optional: true,
+				Computed: true,
+			},
+			"logo_url": schema.StringAttribute{
+				Description: "The URL of the application's logo, if one is set.",
+				Computed:    true,
+			},
+			"home_url": schema.StringAttribute{
+				Description: "The application's home URL, if one is set.",
+				Computed:    true,
+			},
+			"created_at": schema.Int64Attribute{
+				Description: "Unix timestamp in milliseconds of when the application was created.",
+				Computed:    true,
 			},
 			"dev_instance_id": schema.StringAttribute{
 				Description: "The instance ID for the development environment.",
@@ -72,6 +121,31 @@ func (d *ApplicationDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 				Description: "The publishable key for the production instance.",
 				Computed:    true,
 			},
+			"instances": schema.MapNestedAttribute{
+				Description: "The application's instances keyed by environment type (e.g. development, staging, production).",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"instance_id": schema.StringAttribute{
+							Description: "The instance ID.",
+							Computed:    true,
+						},
+						"publishable_key": schema.StringAttribute{
+							Description: "The publishable key for the instance.",
+							Computed:    true,
+						},
+						"secret_key": schema.StringAttribute{
+							Description: "The secret key for the instance.",
+							Computed:    true,
+							Sensitive:   true,
+						},
+						"frontend_api_url": schema.StringAttribute{
+							Description: "The Frontend API URL of the instance.",
+							Computed:    true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -100,13 +174,55 @@ func (d *ApplicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	application, err := d.client.GetApplication(ctx, data.ID.ValueString(), true)
-	if err != nil {
-		resp.Diagnostics.AddError("Error reading Clerk application", err.Error())
-		return
+	var application *client.PlatformApplicationResponse
+	if !data.ID.IsNull() {
+		var err error
+		application, err = d.client.GetApplication(ctx, data.ID.ValueString(), true)
+		if err != nil {
+			diagnostics.AddAPIError(&resp.Diagnostics, "Error reading Clerk application", err, nil)
+			return
+		}
+	} else {
+		application = d.findApplicationByName(ctx, data.Name.ValueString(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
+	data.ID = types.StringValue(application.ApplicationID)
+	data.Name = types.StringNull()
+	if application.Name != "" {
+		data.Name = types.StringValue(application.Name)
+	}
+	data.LogoURL = types.StringNull()
+	if application.LogoURL != "" {
+		data.LogoURL = types.StringValue(application.LogoURL)
+	}
+	data.HomeURL = types.StringNull()
+	if application.HomeURL != "" {
+		data.HomeURL = types.StringValue(application.HomeURL)
+	}
+	data.CreatedAt = types.Int64Null()
+	if application.CreatedAt != 0 {
+		data.CreatedAt = types.Int64Value(application.CreatedAt)
+	}
+
+	instances := make(map[string]ApplicationInstanceModel, len(application.Instances))
 	for _, inst := range application.Instances {
+		instance := ApplicationInstanceModel{
+			InstanceID:     types.StringValue(inst.InstanceID),
+			PublishableKey: types.StringValue(inst.PublishableKey),
+			SecretKey:      types.StringNull(),
+			FrontendAPIURL: types.StringNull(),
+		}
+		if inst.SecretKey != "" {
+			instance.SecretKey = types.StringValue(inst.SecretKey)
+		}
+		if url := inst.FrontendAPIURL(); url != "" {
+			instance.FrontendAPIURL = types.StringValue(url)
+		}
+		instances[inst.EnvironmentType] = instance
+
 		switch inst.EnvironmentType {
 		case "development":
 			data.DevInstanceID = types.StringValue(inst.InstanceID)
@@ -123,5 +239,57 @@ func (d *ApplicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 		}
 	}
 
+	instancesMap, diags := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: applicationInstanceAttrTypes}, instances)
+	resp.Diagnostics.Append(diags...)
+	data.Instances = instancesMap
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// findApplicationByName returns the only application with the given name,
+// including its secret keys. Names are not unique in Clerk, so zero or several
+// matches are errors. The applications are listed without secret keys, and
+// only the matching application is fetched with them.
+func (d *ApplicationDataSource) findApplicationByName(ctx context.Context, name string, diags *diag.Diagnostics) *client.PlatformApplicationResponse {
+	applications, err := d.client.ListApplications(ctx, false)
+	if err != nil {
+		diagnostics.AddAPIError(diags, "Error listing Clerk applications", err, nil)
+		return nil
+	}
+
+	var matches []client.PlatformApplicationResponse
+	for _, application := range applications {
+		if application.Name == name {
+			matches = append(matches, application)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		diags.AddAttributeError(
+			path.Root("name"),
+			"Application Not Found",
+			fmt.Sprintf("No Clerk application is named %q.", name),
+		)
+		return nil
+	case 1:
+		application, err := d.client.GetApplication(ctx, matches[0].ApplicationID, true)
+		if err != nil {
+			diagnostics.AddAPIError(diags, "Error reading Clerk application", err, nil)
+			return nil
+		}
+		return application
+	default:
+		ids := make([]string, len(matches))
+		for i, application := range matches {
+			ids[i] = application.ApplicationID
+		}
+		diags.AddAttributeError(
+			path.Root("name"),
+			"Multiple Applications Found",
+			fmt.Sprintf("%d Clerk applications are named %q (%s). Look up the application by id instead.",
+				len(matches), name, strings.Join(ids, ", ")),
+		)
+		return nil
+	}
+}
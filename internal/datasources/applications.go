@@ -0,0 +1,237 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/makolabsai/terraform-provider-clerk/internal/client"
+	"github.com/makolabsai/terraform-provider-clerk/internal/diagnostics"
+)
+
+var (
+	_ datasource.DataSource = (*ApplicationsDataSource)(nil)
+)
+
+// ApplicationsDataSource lists the Clerk applications in the workspace via the
+// Platform API.
+type ApplicationsDataSource struct {
+	client *client.ClerkClient
+}
+
+// ApplicationsDataSourceModel describes the Terraform data source model.
+type ApplicationsDataSourceModel struct {
+	NamePrefix   types.String `tfsdk:"name_prefix"`
+	NameRegex    types.String `tfsdk:"name_regex"`
+	Applications types.List   `tfsdk:"applications"`
+}
+
+// ApplicationsItemModel describes an entry of the applications list.
+type ApplicationsItemModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	LogoURL   types.String `tfsdk:"logo_url"`
+	HomeURL   types.String `tfsdk:"home_url"`
+	CreatedAt types.Int64  `tfsdk:"created_at"`
+	Instances types.Map    `tfsdk:"instances"`
+}
+
+// ApplicationsInstanceModel describes an entry of an application's instances
+// map. Secret keys are deliberately not listed.
+type ApplicationsInstanceModel struct {
+	InstanceID     types.String `tfsdk:"instance_id"`
+	PublishableKey types.String `tfsdk:"publishable_key"`
+	FrontendAPIURL types.String `tfsdk:"frontend_api_url"`
+}
+
+var applicationsInstanceAttrTypes = map[string]attr.Type{
+	"instance_id":      types.StringType,
+	"publishable_key":  types.StringType,
+	"frontend_api_url": types.StringType,
+}
+
+var applicationsItemAttrTypes = map[string]attr.Type{
+	"id":         types.StringType,
+	"name":       types.StringType,
+	"logo_url":   types.StringType,
+	"home_url":   types.StringType,
+	"created_at": types.Int64Type,
+	"instances":  types.MapType{ElemType: types.ObjectType{AttrTypes: applicationsInstanceAttrTypes}},
+}
+
+func NewApplicationsDataSource() datasource.DataSource {
+	return &ApplicationsDataSource{}
+}
+
+func (d *ApplicationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_applications"
+}
+
+func (d *ApplicationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the Clerk applications in the workspace, optionally filtered by name. " +
+			"Secret keys are not included; use the clerk_application data source or the clerk_instance_keys ephemeral resource for those.",
+		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				Description: "Only return applications whose name starts with this prefix.",
+				Optional:    true,
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Only return applications whose name matches this regular expression (RE2 syntax).",
+				Optional:    true,
+			},
+			"applications": schema.ListNestedAttribute{
+				Description: "The matching applications.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The unique identifier of the application.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the application.",
+							Computed:    true,
+						},
+						"logo_url": schema.StringAttribute{
+							Description: "The URL of the application's logo, if one is set.",
+							Computed:    true,
+						},
+						"home_url": schema.StringAttribute{
+							Description: "The application's home URL, if one is set.",
+							Computed:    true,
+						},
+						"created_at": schema.Int64Attribute{
+							Description: "Unix timestamp in milliseconds of when the application was created.",
+							Computed:    true,
+						},
+						"instances": schema.MapNestedAttribute{
+							Description: "The application's instances keyed by environment type (e.g. development, staging, production).",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"instance_id": schema.StringAttribute{
+										Description: "The instance ID.",
+										Computed:    true,
+									},
+									"publishable_key": schema.StringAttribute{
+										Description: "The publishable key for the instance.",
+										Computed:    true,
+									},
+									"frontend_api_url": schema.StringAttribute{
+										Description: "The Frontend API URL of the instance.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ApplicationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clerkClient, ok := req.ProviderData.(*client.ClerkClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClerkClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = clerkClient
+}
+
+func (d *ApplicationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ApplicationsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Name Regex",
+				fmt.Sprintf("Could not compile name_regex: %s", err),
+			)
+			return
+		}
+	}
+
+	applications, err := d.client.ListApplications(ctx, false)
+	if err != nil {
+		diagnostics.AddAPIError(&resp.Diagnostics, "Error listing Clerk applications", err, nil)
+		return
+	}
+
+	items := []ApplicationsItemModel{}
+	for _, application := range applications {
+		if !strings.HasPrefix(application.Name, data.NamePrefix.ValueString()) {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(application.Name) {
+			continue
+		}
+
+		instances := make(map[string]ApplicationsInstanceModel, len(application.Instances))
+		for _, inst := range application.Instances {
+			instance := ApplicationsInstanceModel{
+				InstanceID:     types.StringValue(inst.InstanceID),
+				PublishableKey: types.StringValue(inst.PublishableKey),
+				FrontendAPIURL: types.StringNull(),
+			}
+			if url := inst.FrontendAPIURL(); url != "" {
+				instance.FrontendAPIURL = types.StringValue(url)
+			}
+			instances[inst.EnvironmentType] = instance
+		}
+		instancesMap, diags := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: applicationsInstanceAttrTypes}, instances)
+		resp.Diagnostics.Append(diags...)
+
+		item := ApplicationsItemModel{
+			ID:        types.StringValue(application.ApplicationID),
+			Name:      types.StringValue(application.Name),
+			LogoURL:   types.StringNull(),
+			HomeURL:   types.StringNull(),
+			CreatedAt: types.Int64Null(),
+			Instances: instancesMap,
+		}
+		if application.LogoURL != "" {
+			item.LogoURL = types.StringValue(application.LogoURL)
+		}
+		if application.HomeURL != "" {
+			item.HomeURL = types.StringValue(application.HomeURL)
+		}
+		if application.CreatedAt != 0 {
+			item.CreatedAt = types.Int64Value(application.CreatedAt)
+		}
+		items = append(items, item)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applicationsList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: applicationsItemAttrTypes}, items)
+	resp.Diagnostics.Append(diags...)
+	data.Applications = applicationsList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/makolabsai/terraform-provider-clerk/internal/client"
+	"github.com/makolabsai/terraform-provider-clerk/internal/diagnostics"
 )
 
 var (
@@ -24,14 +26,20 @@ type OrganizationDataSource struct {
 
 // OrganizationDataSourceModel describes the Terraform data source model.
 type OrganizationDataSourceModel struct {
-	ApplicationID         types.String `tfsdk:"application_id"`
-	Environment           types.String `tfsdk:"environment"`
-	ID                    types.String `tfsdk:"id"`
-	Slug                  types.String `tfsdk:"slug"`
-	Name                  types.String `tfsdk:"name"`
-	MaxAllowedMemberships types.Int64  `tfsdk:"max_allowed_memberships"`
-	AdminDeleteEnabled    types.Bool   `tfsdk:"admin_delete_enabled"`
-	CreatedAt             types.Int64  `tfsdk:"created_at"`
+	ApplicationID         types.String         `tfsdk:"application_id"`
+	Environment           types.String         `tfsdk:"environment"`
+	ID                    types.String         `tfsdk:"id"`
+	Slug                  types.String         `tfsdk:"slug"`
+	Name                  types.String         `tfsdk:"name"`
+	MaxAllowedMemberships types.Int64          `tfsdk:"max_allowed_memberships"`
+	AdminDeleteEnabled    types.Bool           `tfsdk:"admin_delete_enabled"`
+	PublicMetadata        jsontypes.Normalized `tfsdk:"public_metadata"`
+	PrivateMetadata       jsontypes.Normalized `tfsdk:"private_metadata"`
+	CreatedBy             types.String         `tfsdk:"created_by"`
+	ImageURL              types.String         `tfsdk:"image_url"`
+	HasImage              types.Bool           `tfsdk:"has_image"`
+	MembersCount          types.Int64          `tfsdk:"members_count"`
+	CreatedAt             types.Int64          `tfsdk:"created_at"`
 }
 
 func NewOrganizationDataSource() datasource.DataSource {
@@ -51,10 +59,10 @@ func (d *OrganizationDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 				Required:    true,
 			},
 			"environment": schema.StringAttribute{
-				Description: "The environment type: \"development\" or \"production\".",
+				Description: "The environment type, e.g. \"development\", \"staging\" or \"production\".",
 				Required:    true,
 				Validators: []validator.String{
-					stringvalidator.OneOf("development", "production"),
+					stringvalidator.LengthAtLeast(1),
 				},
 			},
 			"id": schema.StringAttribute{
@@ -82,6 +90,33 @@ func (d *OrganizationDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 				Description: "Whether organization admins can delete the organization.",
 				Computed:    true,
 			},
+			"public_metadata": schema.StringAttribute{
+				Description: "Metadata visible to the organization's members, as a JSON object.",
+				CustomType:  jsontypes.NormalizedType{},
+				Computed:    true,
+			},
+			"private_metadata": schema.StringAttribute{
+				Description: "Metadata only visible to the Backend API, as a JSON object.",
+				CustomType:  jsontypes.NormalizedType{},
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"created_by": schema.StringAttribute{
+				Description: "ID of the user who created the organization, if any.",
+				Computed:    true,
+			},
+			"image_url": schema.StringAttribute{
+				Description: "The URL of the organization's logo as hosted by Clerk.",
+				Computed:    true,
+			},
+			"has_image": schema.BoolAttribute{
+				Description: "Whether the organization has a logo.",
+				Computed:    true,
+			},
+			"members_count": schema.Int64Attribute{
+				Description: "The number of members of the organization.",
+				Computed:    true,
+			},
 			"created_at": schema.Int64Attribute{
 				Description: "Unix timestamp of when the organization was created.",
 				Computed:    true,
@@ -125,7 +160,7 @@ func (d *OrganizationDataSource) Read(ctx context.Context, req datasource.ReadRe
 
 	org, err := d.client.GetOrganization(ctx, appID, env, lookupKey)
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading Clerk organization", err.Error())
+		diagnostics.AddAPIError(&resp.Diagnostics, "Error reading Clerk organization", err, nil)
 		return
 	}
 
@@ -134,6 +169,15 @@ func (d *OrganizationDataSource) Read(ctx context.Context, req datasource.ReadRe
 	data.Slug = types.StringValue(org.Slug)
 	data.MaxAllowedMemberships = types.Int64Value(org.MaxAllowedMemberships)
 	data.AdminDeleteEnabled = types.BoolValue(org.AdminDeleteEnabled)
+	data.PublicMetadata = jsontypes.NewNormalizedValue(client.MetadataJSON(org.PublicMetadata))
+	data.PrivateMetadata = jsontypes.NewNormalizedValue(client.MetadataJSON(org.PrivateMetadata))
+	data.CreatedBy = types.StringNull()
+	if org.CreatedBy != "" {
+		data.CreatedBy = types.StringValue(org.CreatedBy)
+	}
+	data.ImageURL = types.StringPointerValue(org.ImageURL)
+	data.HasImage = types.BoolValue(org.HasImage)
+	data.MembersCount = types.Int64PointerValue(org.MembersCount)
 	data.CreatedAt = types.Int64Value(org.CreatedAt)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -0,0 +1,208 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/clerk/clerk-sdk-go/v2"
+	"github.com/clerk/clerk-sdk-go/v2/organization"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/makolabsai/terraform-provider-clerk/internal/client"
+	"github.com/makolabsai/terraform-provider-clerk/internal/diagnostics"
+)
+
+var (
+	_ datasource.DataSource = (*OrganizationsDataSource)(nil)
+)
+
+// OrganizationsDataSource lists the organizations of a Clerk instance via the
+// Backend API.
+type OrganizationsDataSource struct {
+	client *client.ClerkClient
+}
+
+// OrganizationsDataSourceModel describes the Terraform data source model.
+type OrganizationsDataSourceModel struct {
+	ApplicationID       types.String `tfsdk:"application_id"`
+	Environment         types.String `tfsdk:"environment"`
+	Query               types.String `tfsdk:"query"`
+	OrderBy             types.String `tfsdk:"order_by"`
+	IncludeMembersCount types.Bool   `tfsdk:"include_members_count"`
+	Organizations       types.List   `tfsdk:"organizations"`
+}
+
+// OrganizationsItemModel describes an entry of the organizations list.
+type OrganizationsItemModel struct {
+	ID              types.String         `tfsdk:"id"`
+	Name            types.String         `tfsdk:"name"`
+	Slug            types.String         `tfsdk:"slug"`
+	MembersCount    types.Int64          `tfsdk:"members_count"`
+	PublicMetadata  jsontypes.Normalized `tfsdk:"public_metadata"`
+	PrivateMetadata jsontypes.Normalized `tfsdk:"private_metadata"`
+	CreatedAt       types.Int64          `tfsdk:"created_at"`
+}
+
+// orderByPattern matches the sort orders accepted by the organization list endpoint.
+var orderByPattern = regexp.MustCompile(`^[+-]?(name|created_at|members_count)$`)
+
+var organizationsItemAttrTypes = map[string]attr.Type{
+	"id":               types.StringType,
+	"name":             types.StringType,
+	"slug":             types.StringType,
+	"members_count":    types.Int64Type,
+	"public_metadata":  jsontypes.NormalizedType{},
+	"private_metadata": jsontypes.NormalizedType{},
+	"created_at":       types.Int64Type,
+}
+
+func NewOrganizationsDataSource() datasource.DataSource {
+	return &OrganizationsDataSource{}
+}
+
+func (d *OrganizationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organizations"
+}
+
+func (d *OrganizationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the organizations of a Clerk instance, optionally filtered by a search query.",
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Description: "The Clerk application ID the organizations belong to.",
+				Required:    true,
+			},
+			"environment": schema.StringAttribute{
+				Description: "The environment type, e.g. \"development\", \"staging\" or \"production\".",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"query": schema.StringAttribute{
+				Description: "Only return organizations whose ID, name or slug matches this search query.",
+				Optional:    true,
+			},
+			"order_by": schema.StringAttribute{
+				Description: "Sort order of the organizations: name, created_at or members_count, " +
+					"prefixed with + for ascending or - for descending order (e.g. \"-created_at\").",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(orderByPattern,
+						"must be name, created_at or members_count, optionally prefixed with + or -"),
+				},
+			},
+			"include_members_count": schema.BoolAttribute{
+				Description: "Whether to return the number of members of each organization. Defaults to false.",
+				Optional:    true,
+			},
+			"organizations": schema.ListNestedAttribute{
+				Description: "The matching organizations.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The organization ID.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the organization.",
+							Computed:    true,
+						},
+						"slug": schema.StringAttribute{
+							Description: "The URL-friendly slug of the organization.",
+							Computed:    true,
+						},
+						"members_count": schema.Int64Attribute{
+							Description: "The number of members. Null unless include_members_count is true.",
+							Computed:    true,
+						},
+						"public_metadata": schema.StringAttribute{
+							Description: "The organization's public metadata as a JSON string.",
+							CustomType:  jsontypes.NormalizedType{},
+							Computed:    true,
+						},
+						"private_metadata": schema.StringAttribute{
+							Description: "The organization's private metadata as a JSON string.",
+							CustomType:  jsontypes.NormalizedType{},
+							Computed:    true,
+							Sensitive:   true,
+						},
+						"created_at": schema.Int64Attribute{
+							Description: "Unix timestamp in milliseconds of when the organization was created.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *OrganizationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clerkClient, ok := req.ProviderData.(*client.ClerkClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClerkClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = clerkClient
+}
+
+func (d *OrganizationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OrganizationsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &organization.ListParams{}
+	if !data.Query.IsNull() {
+		params.Query = clerk.String(data.Query.ValueString())
+	}
+	if !data.OrderBy.IsNull() {
+		params.OrderBy = clerk.String(data.OrderBy.ValueString())
+	}
+	if !data.IncludeMembersCount.IsNull() {
+		params.IncludeMembersCount = clerk.Bool(data.IncludeMembersCount.ValueBool())
+	}
+
+	orgs, err := d.client.ListOrganizations(ctx, data.ApplicationID.ValueString(), data.Environment.ValueString(), params)
+	if err != nil {
+		diagnostics.AddAPIError(&resp.Diagnostics, "Error listing Clerk organizations", err, nil)
+		return
+	}
+
+	items := make([]OrganizationsItemModel, 0, len(orgs))
+	for _, org := range orgs {
+		item := OrganizationsItemModel{
+			ID:              types.StringValue(org.ID),
+			Name:            types.StringValue(org.Name),
+			Slug:            types.StringValue(org.Slug),
+			MembersCount:    types.Int64PointerValue(org.MembersCount),
+			PublicMetadata:  jsontypes.NewNormalizedValue(client.MetadataJSON(org.PublicMetadata)),
+			PrivateMetadata: jsontypes.NewNormalizedValue(client.MetadataJSON(org.PrivateMetadata)),
+			CreatedAt:       types.Int64Value(org.CreatedAt),
+		}
+		items = append(items, item)
+	}
+
+	orgsList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: organizationsItemAttrTypes}, items)
+	resp.Diagnostics.Append(diags...)
+	data.Organizations = orgsList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,211 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/clerk/clerk-sdk-go/v2"
+	"github.com/clerk/clerk-sdk-go/v2/user"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/makolabsai/terraform-provider-clerk/internal/client"
+	"github.com/makolabsai/terraform-provider-clerk/internal/diagnostics"
+)
+
+var (
+	_ datasource.DataSource = (*UserDataSource)(nil)
+)
+
+// UserDataSource reads a Clerk user via the Backend API.
+type UserDataSource struct {
+	client *client.ClerkClient
+}
+
+// UserDataSourceModel describes the Terraform data source model.
+type UserDataSourceModel struct {
+	ApplicationID       types.String `tfsdk:"application_id"`
+	Environment         types.String `tfsdk:"environment"`
+	ID                  types.String `tfsdk:"id"`
+	EmailAddress        types.String `tfsdk:"email_address"`
+	Username            types.String `tfsdk:"username"`
+	ExternalID          types.String `tfsdk:"external_id"`
+	FirstName           types.String `tfsdk:"first_name"`
+	LastName            types.String `tfsdk:"last_name"`
+	PrimaryEmailAddress types.String `tfsdk:"primary_email_address"`
+	EmailAddresses      types.List   `tfsdk:"email_addresses"`
+	ImageURL            types.String `tfsdk:"image_url"`
+	Banned              types.Bool   `tfsdk:"banned"`
+	Locked              types.Bool   `tfsdk:"locked"`
+	PublicMetadata      types.String `tfsdk:"public_metadata"`
+	CreatedAt           types.Int64  `tfsdk:"created_at"`
+	LastSignInAt        types.Int64  `tfsdk:"last_sign_in_at"`
+}
+
+func NewUserDataSource() datasource.DataSource {
+	return &UserDataSource{}
+}
+
+func (d *UserDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (d *UserDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := userComputedAttributes()
+	attributes["application_id"] = schema.StringAttribute{
+		Description: "The Clerk application ID the user belongs to.",
+		Required:    true,
+	}
+	attributes["environment"] = schema.StringAttribute{
+		Description: "The environment type, e.g. \"development\", \"staging\" or \"production\".",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+	attributes["id"] = schema.StringAttribute{
+		Description: "The user ID to look up. Exactly one of id, email_address, username or external_id must be specified.",
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(
+				path.MatchRoot("id"),
+				path.MatchRoot("email_address"),
+				path.MatchRoot("username"),
+				path.MatchRoot("external_id"),
+			),
+		},
+	}
+	attributes["email_address"] = schema.StringAttribute{
+		Description: "An email address of the user to look up. When not specified, the user's primary email address.",
+		Optional:    true,
+		Computed:    true,
+	}
+	attributes["username"] = schema.StringAttribute{
+		Description: "The username of the user to look up.",
+		Optional:    true,
+		Computed:    true,
+	}
+	attributes["external_id"] = schema.StringAttribute{
+		Description: "The external ID of the user to look up.",
+		Optional:    true,
+		Computed:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Look up an existing Clerk user by ID, email address, username or external ID.",
+		Attributes:  attributes,
+	}
+}
+
+func (d *UserDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clerkClient, ok := req.ProviderData.(*client.ClerkClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClerkClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = clerkClient
+}
+
+func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UserDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	appID := data.ApplicationID.ValueString()
+	env := data.Environment.ValueString()
+
+	var u *clerk.User
+	if !data.ID.IsNull() {
+		var err error
+		u, err = d.client.GetUser(ctx, appID, env, data.ID.ValueString())
+		if err != nil {
+			diagnostics.AddAPIError(&resp.Diagnostics, "Error reading Clerk user", err, nil)
+			return
+		}
+	} else {
+		params := &user.ListParams{}
+		var lookupPath path.Path
+		var lookupValue string
+		switch {
+		case !data.EmailAddress.IsNull():
+			lookupPath, lookupValue = path.Root("email_address"), data.EmailAddress.ValueString()
+			params.EmailAddresses = []string{lookupValue}
+		case !data.Username.IsNull():
+			lookupPath, lookupValue = path.Root("username"), data.Username.ValueString()
+			params.Usernames = []string{lookupValue}
+		default:
+			lookupPath, lookupValue = path.Root("external_id"), data.ExternalID.ValueString()
+			params.ExternalIDs = []string{lookupValue}
+		}
+
+		users, err := d.client.ListUsers(ctx, appID, env, params)
+		if err != nil {
+			diagnostics.AddAPIError(&resp.Diagnostics, "Error listing Clerk users", err, nil)
+			return
+		}
+
+		switch len(users) {
+		case 0:
+			resp.Diagnostics.AddAttributeError(
+				lookupPath,
+				"User Not Found",
+				fmt.Sprintf("No user in %s/%s has %s %q.", appID, env, lookupPath, lookupValue),
+			)
+			return
+		case 1:
+			u = users[0]
+		default:
+			ids := make([]string, len(users))
+			for i, match := range users {
+				ids[i] = match.ID
+			}
+			resp.Diagnostics.AddAttributeError(
+				lookupPath,
+				"Multiple Users Found",
+				fmt.Sprintf("%d users in %s/%s have %s %q (%s). Look up the user by id instead.",
+					len(users), appID, env, lookupPath, lookupValue, strings.Join(ids, ", ")),
+			)
+			return
+		}
+	}
+
+	item, diags := mapUserToItem(ctx, u)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = item.ID
+	data.Username = item.Username
+	data.ExternalID = item.ExternalID
+	if data.EmailAddress.IsNull() {
+		data.EmailAddress = item.PrimaryEmailAddress
+	}
+	data.FirstName = item.FirstName
+	data.LastName = item.LastName
+	data.PrimaryEmailAddress = item.PrimaryEmailAddress
+	data.EmailAddresses = item.EmailAddresses
+	data.ImageURL = item.ImageURL
+	data.Banned = item.Banned
+	data.Locked = item.Locked
+	data.PublicMetadata = item.PublicMetadata
+	data.CreatedAt = item.CreatedAt
+	data.LastSignInAt = item.LastSignInAt
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
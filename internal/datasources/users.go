@@ -0,0 +1,310 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/clerk/clerk-sdk-go/v2"
+	"github.com/clerk/clerk-sdk-go/v2/user"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/makolabsai/terraform-provider-clerk/internal/client"
+	"github.com/makolabsai/terraform-provider-clerk/internal/diagnostics"
+)
+
+var (
+	_ datasource.DataSource = (*UsersDataSource)(nil)
+)
+
+// UsersDataSource lists the users of a Clerk instance via the Backend API.
+type UsersDataSource struct {
+	client *client.ClerkClient
+}
+
+// UsersDataSourceModel describes the Terraform data source model.
+type UsersDataSourceModel struct {
+	ApplicationID   types.String `tfsdk:"application_id"`
+	Environment     types.String `tfsdk:"environment"`
+	UserIDs         types.List   `tfsdk:"user_ids"`
+	EmailAddresses  types.List   `tfsdk:"email_addresses"`
+	Usernames       types.List   `tfsdk:"usernames"`
+	ExternalIDs     types.List   `tfsdk:"external_ids"`
+	OrganizationIDs types.List   `tfsdk:"organization_ids"`
+	Query           types.String `tfsdk:"query"`
+	OrderBy         types.String `tfsdk:"order_by"`
+	Users           types.List   `tfsdk:"users"`
+}
+
+// UsersItemModel describes an entry of the users list.
+type UsersItemModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Username            types.String `tfsdk:"username"`
+	ExternalID          types.String `tfsdk:"external_id"`
+	FirstName           types.String `tfsdk:"first_name"`
+	LastName            types.String `tfsdk:"last_name"`
+	PrimaryEmailAddress types.String `tfsdk:"primary_email_address"`
+	EmailAddresses      types.List   `tfsdk:"email_addresses"`
+	ImageURL            types.String `tfsdk:"image_url"`
+	Banned              types.Bool   `tfsdk:"banned"`
+	Locked              types.Bool   `tfsdk:"locked"`
+	PublicMetadata      types.String `tfsdk:"public_metadata"`
+	CreatedAt           types.Int64  `tfsdk:"created_at"`
+	LastSignInAt        types.Int64  `tfsdk:"last_sign_in_at"`
+}
+
+// userOrderByPattern matches the sort orders accepted by the user list endpoint.
+var userOrderByPattern = regexp.MustCompile(`^[+-]?(created_at|updated_at|email_address|web3wallet|first_name|last_name|phone_number|username|last_active_at|last_sign_in_at)$`)
+
+var usersItemAttrTypes = map[string]attr.Type{
+	"id":                    types.StringType,
+	"username":              types.StringType,
+	"external_id":           types.StringType,
+	"first_name":            types.StringType,
+	"last_name":             types.StringType,
+	"primary_email_address": types.StringType,
+	"email_addresses":       types.ListType{ElemType: types.StringType},
+	"image_url":             types.StringType,
+	"banned":                types.BoolType,
+	"locked":                types.BoolType,
+	"public_metadata":       types.StringType,
+	"created_at":            types.Int64Type,
+	"last_sign_in_at":       types.Int64Type,
+}
+
+// userComputedAttributes returns the schema of the user attributes shared by
+// clerk_user and the entries of clerk_users, except the lookup keys.
+func userComputedAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"first_name": schema.StringAttribute{
+			Description: "The user's first name.",
+			Computed:    true,
+		},
+		"last_name": schema.StringAttribute{
+			Description: "The user's last name.",
+			Computed:    true,
+		},
+		"primary_email_address": schema.StringAttribute{
+			Description: "The user's primary email address.",
+			Computed:    true,
+		},
+		"email_addresses": schema.ListAttribute{
+			Description: "All email addresses of the user.",
+			Computed:    true,
+			ElementType: types.StringType,
+		},
+		"image_url": schema.StringAttribute{
+			Description: "The URL of the user's profile image.",
+			Computed:    true,
+		},
+		"banned": schema.BoolAttribute{
+			Description: "Whether the user is banned.",
+			Computed:    true,
+		},
+		"locked": schema.BoolAttribute{
+			Description: "Whether the user is locked out.",
+			Computed:    true,
+		},
+		"public_metadata": schema.StringAttribute{
+			Description: "The user's public metadata as a JSON string.",
+			Computed:    true,
+		},
+		"created_at": schema.Int64Attribute{
+			Description: "Unix timestamp in milliseconds of when the user was created.",
+			Computed:    true,
+		},
+		"last_sign_in_at": schema.Int64Attribute{
+			Description: "Unix timestamp in milliseconds of the user's last sign-in, if any.",
+			Computed:    true,
+		},
+	}
+}
+
+func NewUsersDataSource() datasource.DataSource {
+	return &UsersDataSource{}
+}
+
+func (d *UsersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *UsersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	itemAttributes := userComputedAttributes()
+	itemAttributes["id"] = schema.StringAttribute{
+		Description: "The user ID.",
+		Computed:    true,
+	}
+	itemAttributes["username"] = schema.StringAttribute{
+		Description: "The user's username.",
+		Computed:    true,
+	}
+	itemAttributes["external_id"] = schema.StringAttribute{
+		Description: "The ID of the user in an external system.",
+		Computed:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Lists the users of a Clerk instance, optionally filtered. All filters are combined.",
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Description: "The Clerk application ID the users belong to.",
+				Required:    true,
+			},
+			"environment": schema.StringAttribute{
+				Description: "The environment type, e.g. \"development\", \"staging\" or \"production\".",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"user_ids": schema.ListAttribute{
+				Description: "Only return users with one of these IDs.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators:  []validator.List{listvalidator.SizeAtLeast(1)},
+			},
+			"email_addresses": schema.ListAttribute{
+				Description: "Only return users with one of these email addresses.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators:  []validator.List{listvalidator.SizeAtLeast(1)},
+			},
+			"usernames": schema.ListAttribute{
+				Description: "Only return users with one of these usernames.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators:  []validator.List{listvalidator.SizeAtLeast(1)},
+			},
+			"external_ids": schema.ListAttribute{
+				Description: "Only return users with one of these external IDs.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators:  []validator.List{listvalidator.SizeAtLeast(1)},
+			},
+			"organization_ids": schema.ListAttribute{
+				Description: "Only return users that are members of one of these organizations.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators:  []validator.List{listvalidator.SizeBetween(1, 100)},
+			},
+			"query": schema.StringAttribute{
+				Description: "Only return users whose ID, name, username, email address, phone number or external ID matches this search query.",
+				Optional:    true,
+			},
+			"order_by": schema.StringAttribute{
+				Description: "Sort order of the users, e.g. \"-created_at\" or \"+email_address\". Defaults to \"-created_at\".",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(userOrderByPattern,
+						"must be a sortable user field such as created_at or email_address, optionally prefixed with + or -"),
+				},
+			},
+			"users": schema.ListNestedAttribute{
+				Description: "The matching users.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: itemAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *UsersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clerkClient, ok := req.ProviderData.(*client.ClerkClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ClerkClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = clerkClient
+}
+
+func (d *UsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UsersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &user.ListParams{}
+	resp.Diagnostics.Append(data.UserIDs.ElementsAs(ctx, &params.UserIDs, false)...)
+	resp.Diagnostics.Append(data.EmailAddresses.ElementsAs(ctx, &params.EmailAddresses, false)...)
+	resp.Diagnostics.Append(data.Usernames.ElementsAs(ctx, &params.Usernames, false)...)
+	resp.Diagnostics.Append(data.ExternalIDs.ElementsAs(ctx, &params.ExternalIDs, false)...)
+	resp.Diagnostics.Append(data.OrganizationIDs.ElementsAs(ctx, &params.OrganizationIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !data.Query.IsNull() {
+		params.Query = clerk.String(data.Query.ValueString())
+	}
+	if !data.OrderBy.IsNull() {
+		params.OrderBy = clerk.String(data.OrderBy.ValueString())
+	}
+
+	users, err := d.client.ListUsers(ctx, data.ApplicationID.ValueString(), data.Environment.ValueString(), params)
+	if err != nil {
+		diagnostics.AddAPIError(&resp.Diagnostics, "Error listing Clerk users", err, nil)
+		return
+	}
+
+	items := make([]UsersItemModel, 0, len(users))
+	for _, u := range users {
+		item, diags := mapUserToItem(ctx, u)
+		resp.Diagnostics.Append(diags...)
+		items = append(items, item)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	usersList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: usersItemAttrTypes}, items)
+	resp.Diagnostics.Append(diags...)
+	data.Users = usersList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// mapUserToItem maps a Backend API user to a users list entry.
+func mapUserToItem(ctx context.Context, u *clerk.User) (UsersItemModel, diag.Diagnostics) {
+	item := UsersItemModel{
+		ID:                  types.StringValue(u.ID),
+		Username:            types.StringPointerValue(u.Username),
+		ExternalID:          types.StringPointerValue(u.ExternalID),
+		FirstName:           types.StringPointerValue(u.FirstName),
+		LastName:            types.StringPointerValue(u.LastName),
+		PrimaryEmailAddress: types.StringNull(),
+		ImageURL:            types.StringPointerValue(u.ImageURL),
+		Banned:              types.BoolValue(u.Banned),
+		Locked:              types.BoolValue(u.Locked),
+		PublicMetadata:      types.StringValue(client.MetadataJSON(u.PublicMetadata)),
+		CreatedAt:           types.Int64Value(u.CreatedAt),
+		LastSignInAt:        types.Int64PointerValue(u.LastSignInAt),
+	}
+
+	emails := make([]string, 0, len(u.EmailAddresses))
+	for _, email := range u.EmailAddresses {
+		emails = append(emails, email.EmailAddress)
+		if u.PrimaryEmailAddressID != nil && email.ID == *u.PrimaryEmailAddressID {
+			item.PrimaryEmailAddress = types.StringValue(email.EmailAddress)
+		}
+	}
+	emailsList, diags := types.ListValueFrom(ctx, types.StringType, emails)
+	item.EmailAddresses = emailsList
+
+	return item, diags
+}
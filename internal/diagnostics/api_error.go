@@ -0,0 +1,69 @@
+// Package diagnostics converts Clerk API errors into Terraform diagnostics.
+package diagnostics
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/makolabsai/terraform-provider-clerk/internal/client"
+)
+
+// organizationsDisabledHint explains the error codes Clerk returns when
+// organizations are used before they are enabled.
+const organizationsDisabledHint = "Organizations are not enabled for this instance. Enable them with " +
+	"organization_settings.enabled = true on the clerk_environment resource, and add a depends_on " +
+	"from this resource to it."
+
+// hints maps well-known Clerk error codes to an explanation of what usually
+// causes them and how to fix the configuration.
+var hints = map[string]string{
+	"form_identifier_exists": "Another resource already uses this value. Choose a different value, " +
+		"or import the existing resource into Terraform.",
+	"form_param_format_invalid":            "The value does not have the format Clerk expects.",
+	"form_param_value_invalid":             "Clerk rejected the value. Check the allowed values for this attribute.",
+	"form_param_missing":                   "Clerk requires this value. Set it in the configuration.",
+	"form_param_unknown":                   "Clerk does not recognise this parameter. The Clerk API may have changed.",
+	"organization_not_enabled":             organizationsDisabledHint,
+	"organization_not_enabled_in_instance": organizationsDisabledHint,
+	"resource_not_found": "The resource does not exist in Clerk. It may have been deleted outside of Terraform, " +
+		"or the ID, application_id or environment may be wrong.",
+	"authentication_invalid": "The API key was rejected. Check platform_api_key, CLERK_PLATFORM_API_KEY " +
+		"or backend_secret_keys in the provider configuration.",
+	"authorization_invalid": "The API key is not allowed to perform this operation. Check that it belongs " +
+		"to the right workspace or instance.",
+	"too_many_requests": "Clerk rate limited the request even after retrying. Lower requests_per_second " +
+		"or raise max_retries in the provider configuration.",
+}
+
+// AddAPIError appends error diagnostics for err. Clerk API errors are split
+// into one diagnostic per error detail; details whose param_name appears in
+// paramPaths are attached to that attribute, and well-known error codes get
+// an explanation. Other errors are reported as-is.
+func AddAPIError(diags *diag.Diagnostics, summary string, err error, paramPaths map[string]path.Path) {
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) || len(apiErr.Errors) == 0 {
+		diags.AddError(summary, err.Error())
+		return
+	}
+
+	for _, d := range apiErr.Errors {
+		detail := d.Description()
+		if hint, ok := hints[d.Code]; ok {
+			detail += "\n\n" + hint
+		}
+		if d.Code != "" {
+			detail += fmt.Sprintf("\n\nClerk error code: %s (HTTP %d)", d.Code, apiErr.StatusCode)
+		}
+		if apiErr.TraceID != "" {
+			detail += fmt.Sprintf("\nClerk trace ID: %s", apiErr.TraceID)
+		}
+
+		if attrPath, ok := paramPaths[d.ParamName]; ok && d.ParamName != "" {
+			diags.AddAttributeError(attrPath, summary, detail)
+		} else {
+			diags.AddError(summary, detail)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package diagnostics
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/makolabsai/terraform-provider-clerk/internal/client"
+)
+
+func TestAddAPIError_AttributePath(t *testing.T) {
+	err := &client.APIError{
+		API:        "backend",
+		StatusCode: 422,
+		Errors: []client.APIErrorDetail{
+			{Code: "form_identifier_exists", Message: "taken", LongMessage: "That slug is taken.", ParamName: "slug"},
+		},
+	}
+
+	var diags diag.Diagnostics
+	AddAPIError(&diags, "Error creating Clerk organization", err, map[string]path.Path{
+		"slug": path.Root("slug"),
+	})
+
+	if diags.ErrorsCount() != 1 {
+		t.Fatalf("expected 1 error, got %d", diags.ErrorsCount())
+	}
+	withPath, ok := diags[0].(diag.DiagnosticWithPath)
+	if !ok {
+		t.Fatalf("expected diagnostic with path, got %T", diags[0])
+	}
+	if !withPath.Path().Equal(path.Root("slug")) {
+		t.Errorf("expected path slug, got %s", withPath.Path())
+	}
+	if !strings.Contains(diags[0].Detail(), "That slug is taken.") {
+		t.Errorf("expected long message in detail, got %q", diags[0].Detail())
+	}
+	if !strings.Contains(diags[0].Detail(), "import the existing resource") {
+		t.Errorf("expected hint in detail, got %q", diags[0].Detail())
+	}
+}
+
+func TestAddAPIError_UnknownParam(t *testing.T) {
+	err := &client.APIError{
+		API:        "platform",
+		StatusCode: 400,
+		Errors: []client.APIErrorDetail{
+			{Code: "some_error", Message: "bad", ParamName: "unmapped"},
+		},
+	}
+
+	var diags diag.Diagnostics
+	AddAPIError(&diags, "Error", err, nil)
+
+	if diags.ErrorsCount() != 1 {
+		t.Fatalf("expected 1 error, got %d", diags.ErrorsCount())
+	}
+	if _, ok := diags[0].(diag.DiagnosticWithPath); ok {
+		t.Error("expected diagnostic without path for an unmapped param")
+	}
+}
+
+func TestAddAPIError_NonAPIError(t *testing.T) {
+	var diags diag.Diagnostics
+	AddAPIError(&diags, "Error", errors.New("connection refused"), nil)
+
+	if diags.ErrorsCount() != 1 || diags[0].Detail() != "connection refused" {
+		t.Errorf("expected raw error detail, got %v", diags)
+	}
+}
@@ -0,0 +1,127 @@
+package ephemeralresources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/makolabsai/terraform-provider-clerk/internal/client"
+	"github.com/makolabsai/terraform-provider-clerk/internal/diagnostics"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = (*InstanceKeysEphemeralResource)(nil)
+	_ ephemeral.EphemeralResourceWithConfigure = (*InstanceKeysEphemeralResource)(nil)
+)
+
+// InstanceKeysEphemeralResource reads the API keys of a Clerk instance via the
+// Platform API without persisting them to plan or state.
+type InstanceKeysEphemeralResource struct {
+	client *client.ClerkClient
+}
+
+// InstanceKeysEphemeralResourceModel describes the ephemeral resource data model.
+type InstanceKeysEphemeralResourceModel struct {
+	ApplicationID  types.String `tfsdk:"application_id"`
+	Environment    types.String `tfsdk:"environment"`
+	InstanceID     types.String `tfsdk:"instance_id"`
+	PublishableKey types.String `tfsdk:"publishable_key"`
+	SecretKey      types.String `tfsdk:"secret_key"`
+}
+
+func NewInstanceKeysEphemeralResource() ephemeral.EphemeralResource {
+	return &InstanceKeysEphemeralResource{}
+}
+
+func (e *InstanceKeysEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_instance_keys"
+}
+
+func (e *InstanceKeysEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the secret and publishable keys of a Clerk instance without storing them in plan or state. " +
+			"Use this to feed write-only attributes of secret manager resources. Requires Terraform 1.10 or later.",
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Description: "The Clerk application ID.",
+				Required:    true,
+			},
+			"environment": schema.StringAttribute{
+				Description: "The environment type, e.g. \"development\", \"staging\" or \"production\".",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Description: "The instance ID.",
+				Computed:    true,
+			},
+			"publishable_key": schema.StringAttribute{
+				Description: "The publishable key for the instance.",
+				Computed:    true,
+			},
+			"secret_key": schema.StringAttribute{
+				Description: "The secret key for the instance.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (e *InstanceKeysEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clerkClient, ok := req.ProviderData.(*client.ClerkClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClerkClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	e.client = clerkClient
+}
+
+func (e *InstanceKeysEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data InstanceKeysEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	appID := data.ApplicationID.ValueString()
+	env := data.Environment.ValueString()
+
+	application, err := e.client.GetApplication(ctx, appID, true)
+	if err != nil {
+		diagnostics.AddAPIError(&resp.Diagnostics, "Error reading Clerk application", err, nil)
+		return
+	}
+
+	for _, inst := range application.Instances {
+		if inst.EnvironmentType != env {
+			continue
+		}
+		data.InstanceID = types.StringValue(inst.InstanceID)
+		data.PublishableKey = types.StringValue(inst.PublishableKey)
+		data.SecretKey = types.StringValue(inst.SecretKey)
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		path.Root("environment"),
+		"Instance Not Found",
+		fmt.Sprintf("Application %q has no %s instance.", appID, env),
+	)
+}
@@ -2,10 +2,14 @@ package provider_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
 
 func TestAccClerkApplication_basic(t *testing.T) {
@@ -26,6 +30,11 @@ func TestAccClerkApplication_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "dev_instance_id"),
 					resource.TestCheckResourceAttrSet(resourceName, "dev_publishable_key"),
 					resource.TestCheckResourceAttrSet(resourceName, "dev_secret_key"),
+					resource.TestCheckResourceAttrPair(resourceName, "instances.development.instance_id", resourceName, "dev_instance_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "instances.development.publishable_key", resourceName, "dev_publishable_key"),
+					resource.TestCheckResourceAttrPair(resourceName, "instances.development.secret_key", resourceName, "dev_secret_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "instances.development.frontend_api_url"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
 				),
 			},
 			// Import state.
@@ -33,10 +42,12 @@ func TestAccClerkApplication_basic(t *testing.T) {
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
-				// name is not returned by the API, so it can't be verified on import.
 				// deletion_protection is provider-side only, not in the API.
 				// secret keys also require include_secret_keys=true which import may not trigger identically.
-				ImportStateVerifyIgnore: []string{"name", "template", "deletion_protection", "dev_secret_key", "prod_secret_key"},
+				ImportStateVerifyIgnore: []string{
+					"template", "deletion_protection", "dev_secret_key", "prod_secret_key",
+					"instances.development.secret_key", "instances.production.secret_key",
+				},
 			},
 		},
 	})
@@ -95,6 +106,138 @@ func TestAccClerkApplication_deletionProtection(t *testing.T) {
 	})
 }
 
+func TestAccClerkApplication_hiddenSecretKeys(t *testing.T) {
+	rName := "tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum)
+	resourceName := "clerk_application.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Secret keys stay out of state, but dependent resources still work.
+			{
+				Config: testAccClerkApplicationConfigWithExposeSecretKeys(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "expose_secret_keys", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "dev_publishable_key"),
+					resource.TestCheckNoResourceAttr(resourceName, "dev_secret_key"),
+					resource.TestCheckNoResourceAttr(resourceName, "instances.development.secret_key"),
+					resource.TestCheckResourceAttr("clerk_environment.test", "hibp", "true"),
+				),
+			},
+			// Exposing the keys writes them to state.
+			{
+				Config: testAccClerkApplicationConfigWithExposeSecretKeys(rName, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("dev_secret_key")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "expose_secret_keys", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "dev_secret_key"),
+				),
+			},
+			// Hiding them again removes them from state.
+			{
+				Config: testAccClerkApplicationConfigWithExposeSecretKeys(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "dev_secret_key"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccClerkApplication_addInstance(t *testing.T) {
+	rName := "tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum)
+	resourceName := "clerk_application.test"
+	var applicationID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Start development-only.
+			{
+				Config: testAccClerkApplicationConfigWithEnvironmentTypes(rName, `["development"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "dev_instance_id"),
+					resource.TestCheckNoResourceAttr(resourceName, "prod_instance_id"),
+					func(s *terraform.State) error {
+						applicationID = s.RootModule().Resources[resourceName].Primary.ID
+						return nil
+					},
+				),
+			},
+			// Adding production is an in-place update that keeps the application.
+			{
+				Config: testAccClerkApplicationConfigWithEnvironmentTypes(rName, `["development", "production"]`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("prod_instance_id")),
+						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("prod_secret_key")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "prod_instance_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "instances.production.publishable_key"),
+					func(s *terraform.State) error {
+						if id := s.RootModule().Resources[resourceName].Primary.ID; id != applicationID {
+							return fmt.Errorf("application was replaced: %s != %s", id, applicationID)
+						}
+						return nil
+					},
+				),
+			},
+			// Removing an environment type forces replacement.
+			{
+				Config: testAccClerkApplicationConfigWithEnvironmentTypes(rName, `["development"]`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccClerkApplication_domain(t *testing.T) {
+	rName := "tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum)
+	resourceName := "clerk_application.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClerkApplicationConfigWithDomain(rName, rName+".example.com", "/__clerk"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "domain", rName+".example.com"),
+					resource.TestCheckResourceAttr(resourceName, "proxy_path", "/__clerk"),
+					resource.TestCheckResourceAttrSet(resourceName, "dns_records.0.host"),
+					resource.TestCheckResourceAttrSet(resourceName, "dns_records.0.value"),
+				),
+			},
+			// Changing the domain updates the production instance in place.
+			{
+				Config: testAccClerkApplicationConfigWithDomain(rName, rName+".example.org", "/__clerk"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "domain", rName+".example.org"),
+					resource.TestMatchResourceAttr(resourceName, "dns_records.0.host", regexp.MustCompile(`\.example\.org$`)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccClerkApplicationDataSource_basic(t *testing.T) {
 	rName := "tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum)
 	resourceName := "clerk_application.test"
@@ -108,14 +251,42 @@ func TestAccClerkApplicationDataSource_basic(t *testing.T) {
 				Config: testAccClerkApplicationDataSourceConfig(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "created_at", resourceName, "created_at"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "dev_instance_id", resourceName, "dev_instance_id"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "dev_publishable_key", resourceName, "dev_publishable_key"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instances.development.instance_id", resourceName, "instances.development.instance_id"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccClerkApplicationDataSource_byName(t *testing.T) {
+	rName := "tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum)
+	resourceName := "clerk_application.test"
+	dataSourceName := "data.clerk_application.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClerkApplicationDataSourceConfigByName(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instances.development.instance_id", resourceName, "instances.development.instance_id"),
+				),
+			},
+			{
+				Config:      testAccClerkApplicationDataSourceConfigByName(rName) + testAccClerkApplicationDataSourceMissingConfig(rName),
+				ExpectError: regexp.MustCompile(`Application Not Found`),
+			},
+		},
+	})
+}
+
 func testAccClerkApplicationConfig(name string) string {
 	return testAccProviderConfig() + fmt.Sprintf(`
 resource "clerk_application" "test" {
@@ -146,3 +317,64 @@ data "clerk_application" "test" {
 }
 `, name)
 }
+
+func testAccClerkApplicationDataSourceConfigByName(name string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "clerk_application" "test" {
+  name                = %[1]q
+  deletion_protection = false
+}
+
+data "clerk_application" "test" {
+  name = clerk_application.test.name
+}
+`, name)
+}
+
+func testAccClerkApplicationDataSourceMissingConfig(name string) string {
+	return fmt.Sprintf(`
+data "clerk_application" "missing" {
+  name       = "%[1]s-missing"
+  depends_on = [clerk_application.test]
+}
+`, name)
+}
+
+func testAccClerkApplicationConfigWithExposeSecretKeys(name string, expose bool) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "clerk_application" "test" {
+  name                = %[1]q
+  deletion_protection = false
+  expose_secret_keys  = %[2]t
+}
+
+resource "clerk_environment" "test" {
+  application_id = clerk_application.test.id
+  environment    = "development"
+  hibp           = true
+}
+`, name, expose)
+}
+
+func testAccClerkApplicationConfigWithEnvironmentTypes(name, environmentTypes string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "clerk_application" "test" {
+  name                = %[1]q
+  domain              = "%[1]s.example.com"
+  environment_types   = %[2]s
+  deletion_protection = false
+}
+`, name, environmentTypes)
+}
+
+func testAccClerkApplicationConfigWithDomain(name, domain, proxyPath string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "clerk_application" "test" {
+  name                = %[1]q
+  domain              = %[2]q
+  proxy_path          = %[3]q
+  environment_types   = ["development", "production"]
+  deletion_protection = false
+}
+`, name, domain, proxyPath)
+}
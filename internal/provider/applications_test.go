@@ -0,0 +1,55 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccClerkApplicationsDataSource_filtered(t *testing.T) {
+	rName := "tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum)
+	dataSourceName := "data.clerk_applications.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClerkApplicationsDataSourceConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "applications.#", "2"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "applications.0.instances.development.instance_id"),
+					resource.TestCheckNoResourceAttr(dataSourceName, "applications.0.instances.development.secret_key"),
+					resource.TestCheckResourceAttr("data.clerk_applications.regex", "applications.#", "1"),
+					resource.TestCheckResourceAttrPair("data.clerk_applications.regex", "applications.0.id", "clerk_application.a", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccClerkApplicationsDataSourceConfig(name string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "clerk_application" "a" {
+  name                = "%[1]s-a"
+  deletion_protection = false
+}
+
+resource "clerk_application" "b" {
+  name                = "%[1]s-b"
+  deletion_protection = false
+}
+
+data "clerk_applications" "test" {
+  name_prefix = %[1]q
+  depends_on  = [clerk_application.a, clerk_application.b]
+}
+
+data "clerk_applications" "regex" {
+  name_regex = "^%[1]s-a$"
+  depends_on = [clerk_application.a, clerk_application.b]
+}
+`, name)
+}
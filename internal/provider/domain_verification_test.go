@@ -0,0 +1,64 @@
+package provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// The example.com domains used in acceptance tests can never be verified, so
+// this only covers the timeout path of the waiter.
+func TestAccClerkDomainVerification_timeout(t *testing.T) {
+	rName := "tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccClerkDomainVerificationConfig(rName, "2s"),
+				ExpectError: regexp.MustCompile(`Timed out waiting for domain verification`),
+			},
+		},
+	})
+}
+
+func TestAccClerkDomainVerification_invalidPollInterval(t *testing.T) {
+	rName := "tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccClerkDomainVerificationConfig(rName, "soon"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Duration`),
+			},
+		},
+	})
+}
+
+func testAccClerkDomainVerificationConfig(name, pollInterval string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "clerk_application" "test" {
+  name                = %[1]q
+  domain              = "%[1]s.example.com"
+  environment_types   = ["development", "production"]
+  deletion_protection = false
+}
+
+resource "clerk_domain_verification" "test" {
+  application_id = clerk_application.test.id
+  domain         = clerk_application.test.domain
+  poll_interval  = %[2]q
+
+  timeouts {
+    create = "10s"
+  }
+}
+`, name, pollInterval)
+}
@@ -82,6 +82,7 @@ resource "clerk_organization" "e2e" {
   environment             = "development"
   name                    = %[2]q
   max_allowed_memberships = %[3]d
+  deletion_protection     = false
 
   depends_on = [clerk_environment.e2e]
 }
@@ -68,6 +68,33 @@ func TestAccClerkEnvironment_organizationSettings(t *testing.T) {
 	})
 }
 
+func TestAccClerkEnvironment_organizationRoles(t *testing.T) {
+	rName := "tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum)
+	resourceName := "clerk_environment.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClerkEnvironmentConfig_orgRoles(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "organization_settings.creator_role", "org:admin"),
+					resource.TestCheckResourceAttr(resourceName, "organization_settings.creator_role_key", "org:admin"),
+					resource.TestCheckResourceAttrSet(resourceName, "organization_settings.creator_role_id"),
+					resource.TestCheckResourceAttr(resourceName, "organization_settings.domains_default_role_key", "org:member"),
+					resource.TestCheckResourceAttrSet(resourceName, "organization_settings.domains_default_role_id"),
+				),
+			},
+			// Re-applying the same config must not produce a diff.
+			{
+				Config:   testAccClerkEnvironmentConfig_orgRoles(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccClerkEnvironment_update(t *testing.T) {
 	rName := "tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum)
 	resourceName := "clerk_environment.test"
@@ -187,3 +214,23 @@ resource "clerk_environment" "test" {
 }
 `, name)
 }
+
+func testAccClerkEnvironmentConfig_orgRoles(name string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "clerk_application" "test" {
+  name                = %[1]q
+  deletion_protection = false
+}
+
+resource "clerk_environment" "test" {
+  application_id = clerk_application.test.id
+  environment    = "development"
+
+  organization_settings = {
+    enabled              = true
+    creator_role         = "org:admin"
+    domains_default_role = "org:member"
+  }
+}
+`, name)
+}
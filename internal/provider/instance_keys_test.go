@@ -0,0 +1,65 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// testAccProtoV6ProviderFactoriesWithEcho adds the echo provider, which copies
+// ephemeral values into state so that tests can assert on them.
+var testAccProtoV6ProviderFactoriesWithEcho = map[string]func() (tfprotov6.ProviderServer, error){
+	"clerk": testAccProtoV6ProviderFactories["clerk"],
+	"echo":  echoprovider.NewProviderServer(),
+}
+
+func TestAccClerkInstanceKeysEphemeralResource_basic(t *testing.T) {
+	rName := "tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithEcho,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClerkApplicationConfig(rName),
+			},
+			{
+				Config: testAccClerkInstanceKeysEphemeralResourceConfig(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("instance_id"),
+						knownvalue.StringRegexp(regexp.MustCompile(`^ins_`))),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("publishable_key"),
+						knownvalue.StringRegexp(regexp.MustCompile(`^pk_test_`))),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("secret_key"),
+						knownvalue.StringRegexp(regexp.MustCompile(`^sk_test_`))),
+				},
+			},
+		},
+	})
+}
+
+func testAccClerkInstanceKeysEphemeralResourceConfig(name string) string {
+	return testAccClerkApplicationConfig(name) + `
+ephemeral "clerk_instance_keys" "test" {
+  application_id = clerk_application.test.id
+  environment    = "development"
+}
+
+provider "echo" {
+  data = ephemeral.clerk_instance_keys.test
+}
+
+resource "echo" "test" {}
+`
+}
@@ -1,12 +1,29 @@
 package provider_test
 
 import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/clerk/clerk-sdk-go/v2"
+	"github.com/clerk/clerk-sdk-go/v2/user"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
 
 func TestAccClerkOrganization_basic(t *testing.T) {
@@ -112,6 +129,276 @@ func TestAccClerkOrganization_import(t *testing.T) {
 					), nil
 				},
 				ImportStateVerify: true,
+				// Imported organizations are protected until the configuration says otherwise.
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
+			},
+		},
+	})
+}
+
+func TestAccClerkOrganization_deletionProtection(t *testing.T) {
+	rName := "tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum)
+	orgName := "Test Org " + acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
+	resourceName := "clerk_organization.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with deletion_protection left at its default.
+			{
+				Config: testAccClerkOrganizationConfigWithDeletionProtection(rName, orgName, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "true"),
+				),
+			},
+			// Removing the organization from the configuration fails at plan time.
+			{
+				Config:      testAccClerkOrganizationBase(rName),
+				ExpectError: regexp.MustCompile(`Cannot destroy organization with deletion protection`),
+			},
+			// Disable deletion_protection so the test can clean up.
+			{
+				Config: testAccClerkOrganizationConfigWithDeletionProtection(rName, orgName, "false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccClerkOrganization_createEmptyPlan creates one organization per
+// combination of optional attributes and asserts that none of them has a diff
+// right after create. The later steps set and then unset created_by and
+// logo_path on top of those combinations.
+func TestAccClerkOrganization_createEmptyPlan(t *testing.T) {
+	rName := "tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum)
+	orgName := "Test Org " + acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
+	slugSuffix := strings.ToLower(acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum))
+	ownerEmail := "owner+" + strings.ToLower(rName) + "@example.com"
+
+	logoPath := filepath.Join(t.TempDir(), "logo.png")
+	if err := os.WriteFile(logoPath, testAccPNG, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	// The provider does not manage users, so the owner is created through the
+	// Backend API with the secret key of the application from the first step.
+	var secretKey string
+
+	keys := []string{"admin_delete_unset", "admin_delete_true", "admin_delete_false"}
+	expectActions := func(actions map[int]plancheck.ResourceActionType) []plancheck.PlanCheck {
+		var checks []plancheck.PlanCheck
+		for _, key := range keys {
+			for i, action := range actions {
+				checks = append(checks, plancheck.ExpectResourceAction(fmt.Sprintf("clerk_organization.%s_%d", key, i), action))
+			}
+		}
+		return checks
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClerkOrganizationConfig_combinations(rName, orgName, slugSuffix, "", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("clerk_organization.admin_delete_true_0", "admin_delete_enabled", "true"),
+					resource.TestCheckResourceAttr("clerk_organization.admin_delete_false_0", "admin_delete_enabled", "false"),
+					resource.TestCheckResourceAttr("clerk_organization.admin_delete_false_1", "max_allowed_memberships", "7"),
+					resource.TestCheckNoResourceAttr("clerk_organization.admin_delete_unset_0", "logo_sha256"),
+					func(s *terraform.State) error {
+						rs, ok := s.RootModule().Resources["clerk_application.test"]
+						if !ok {
+							return fmt.Errorf("clerk_application.test not found in state")
+						}
+						secretKey = rs.Primary.Attributes["dev_secret_key"]
+						if secretKey == "" {
+							return fmt.Errorf("clerk_application.test has no dev_secret_key")
+						}
+						return nil
+					},
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				// Setting logo_path updates in place; setting created_by
+				// replaces the organization.
+				PreConfig: func() {
+					testAccCreateUser(t, secretKey, ownerEmail)
+				},
+				Config: testAccClerkOrganizationConfig_combinations(rName, orgName, slugSuffix, ownerEmail, logoPath),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("clerk_organization.admin_delete_unset_0", "logo_sha256", testAccSHA256(testAccPNG)),
+					resource.TestCheckResourceAttr("clerk_organization.admin_delete_false_0", "admin_delete_enabled", "false"),
+					resource.TestCheckResourceAttrPair("clerk_organization.admin_delete_true_1", "created_by", "data.clerk_user.owner", "id"),
+					resource.TestCheckResourceAttr("clerk_organization.admin_delete_true_1", "max_allowed_memberships", "7"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: expectActions(map[int]plancheck.ResourceActionType{
+						0: plancheck.ResourceActionUpdate,
+						1: plancheck.ResourceActionReplace,
+					}),
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				// Removing logo_path clears the logo. created_by is optional
+				// and computed, so removing it keeps the creator from state
+				// instead of replacing the organization.
+				Config: testAccClerkOrganizationConfig_combinations(rName, orgName, slugSuffix, "", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("clerk_organization.admin_delete_unset_0", "logo_sha256"),
+					resource.TestCheckResourceAttrSet("clerk_organization.admin_delete_true_1", "created_by"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: expectActions(map[int]plancheck.ResourceActionType{
+						0: plancheck.ResourceActionUpdate,
+						1: plancheck.ResourceActionNoop,
+					}),
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+// testAccCreateUser creates a user with the given email address in the
+// instance that secretKey belongs to.
+func testAccCreateUser(t *testing.T, secretKey, email string) {
+	t.Helper()
+	users := user.NewClient(&clerk.ClientConfig{
+		BackendConfig: clerk.BackendConfig{Key: clerk.String(secretKey)},
+	})
+	_, err := users.Create(context.Background(), &user.CreateParams{
+		EmailAddresses:          &[]string{email},
+		SkipPasswordRequirement: clerk.Bool(true),
+	})
+	if err != nil {
+		t.Fatalf("creating user %s: %s", email, err)
+	}
+}
+
+func TestAccClerkOrganization_metadata(t *testing.T) {
+	rName := "tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum)
+	orgName := "Test Org " + acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
+	resourceName := "clerk_organization.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClerkOrganizationConfig_metadata(rName, orgName, "pro"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "members_count", "0"),
+					resource.TestCheckResourceAttr(resourceName, "has_image", "false"),
+				),
+			},
+			// Formatting and key order differences must not show up as a diff.
+			{
+				Config: testAccClerkOrganizationConfig_metadata(rName, orgName, "pro"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: testAccClerkOrganizationConfig_metadata(rName, orgName, "enterprise"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith(resourceName, "public_metadata", func(value string) error {
+						if !strings.Contains(value, `"enterprise"`) {
+							return fmt.Errorf("expected public_metadata to contain the new plan tier, got %s", value)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccClerkOrganization_logo(t *testing.T) {
+	rName := "tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum)
+	orgName := "Test Org " + acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
+	resourceName := "clerk_organization.test"
+
+	logoPath := filepath.Join(t.TempDir(), "logo.png")
+	if err := os.WriteFile(logoPath, testAccPNG, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	logoServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		w.Write(testAccPNG)
+	}))
+	defer logoServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClerkOrganizationConfig_logo(rName, orgName, logoPath),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "has_image", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "image_url"),
+					resource.TestCheckResourceAttr(resourceName, "logo_sha256", testAccSHA256(testAccPNG)),
+				),
+			},
+			// Changing the file contents at the same path uploads the logo in place.
+			{
+				PreConfig: func() {
+					if err := os.WriteFile(logoPath, testAccOpaquePNG(t), 0o600); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccClerkOrganizationConfig_logo(rName, orgName, logoPath),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "has_image", "true"),
+					resource.TestCheckResourceAttr(resourceName, "logo_sha256", testAccSHA256(testAccOpaquePNG(t))),
+				),
+			},
+			// Switching to a source URL downloads the logo during apply.
+			{
+				Config: testAccClerkOrganizationConfig_logoSourceURL(rName, orgName, logoServer.URL+"/logo.png"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("logo_sha256")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "has_image", "true"),
+					resource.TestCheckResourceAttr(resourceName, "logo_sha256", testAccSHA256(testAccPNG)),
+				),
+			},
+			{
+				Config: testAccClerkOrganizationConfig_basic(rName, orgName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "has_image", "false"),
+				),
 			},
 		},
 	})
@@ -133,6 +420,8 @@ func TestAccClerkOrganizationDataSource_byId(t *testing.T) {
 					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "slug", resourceName, "slug"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "members_count", resourceName, "members_count"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "public_metadata", resourceName, "public_metadata"),
 				),
 			},
 		},
@@ -161,6 +450,30 @@ func TestAccClerkOrganizationDataSource_bySlug(t *testing.T) {
 	})
 }
 
+func TestAccClerkOrganizationsDataSource_query(t *testing.T) {
+	rName := "tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum)
+	orgName := "Test Org " + acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
+	resourceName := "clerk_organization.test"
+	dataSourceName := "data.clerk_organizations.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClerkOrganizationsDataSourceConfig_query(rName, orgName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "organizations.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "organizations.0.id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "organizations.0.slug", resourceName, "slug"),
+					resource.TestCheckResourceAttr(dataSourceName, "organizations.0.members_count", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "organizations.0.public_metadata", "{}"),
+				),
+			},
+		},
+	})
+}
+
 // --- Config helpers ---
 
 // testAccClerkOrganizationBase returns the shared app + environment config
@@ -190,11 +503,32 @@ resource "clerk_organization" "test" {
   environment    = "development"
   name           = %[1]q
 
+  deletion_protection = false
+
   depends_on = [clerk_environment.test]
 }
 `, orgName)
 }
 
+// testAccClerkOrganizationConfigWithDeletionProtection omits deletion_protection
+// when protected is empty.
+func testAccClerkOrganizationConfigWithDeletionProtection(appName, orgName, protected string) string {
+	attr := ""
+	if protected != "" {
+		attr = "deletion_protection = " + protected
+	}
+	return testAccClerkOrganizationBase(appName) + fmt.Sprintf(`
+resource "clerk_organization" "test" {
+  application_id = clerk_application.test.id
+  environment    = "development"
+  name           = %[1]q
+  %[2]s
+
+  depends_on = [clerk_environment.test]
+}
+`, orgName, attr)
+}
+
 func testAccClerkOrganizationConfig_maxMembers(appName, orgName string, maxMembers int) string {
 	return testAccClerkOrganizationBase(appName) + fmt.Sprintf(`
 resource "clerk_organization" "test" {
@@ -203,11 +537,154 @@ resource "clerk_organization" "test" {
   name                  = %[1]q
   max_allowed_memberships = %[2]d
 
+  deletion_protection = false
+
   depends_on = [clerk_environment.test]
 }
 `, orgName, maxMembers)
 }
 
+// testAccClerkOrganizationConfig_combinations declares an organization for each
+// admin_delete_enabled setting (unset, true, false), once with only the
+// required attributes and once with every other optional attribute set.
+// testAccClerkOrganizationConfig_combinations declares one organization per
+// combination of admin_delete_enabled and the optional attributes. A non-empty
+// ownerEmail sets created_by on the organizations with optional attributes;
+// a non-empty logoPath sets logo_path on the others.
+func testAccClerkOrganizationConfig_combinations(appName, orgName, slugSuffix, ownerEmail, logoPath string) string {
+	adminDelete := map[string]string{
+		"admin_delete_unset": "",
+		"admin_delete_true":  "admin_delete_enabled = true",
+		"admin_delete_false": "admin_delete_enabled = false",
+	}
+	optional := []string{
+		"",
+		`slug                    = "%[1]s"
+  max_allowed_memberships = 7
+  public_metadata         = jsonencode({ plan = "pro" })
+  private_metadata        = jsonencode({ billing_id = "cus_123" })`,
+	}
+
+	config := testAccClerkOrganizationBase(appName)
+	if ownerEmail != "" {
+		config += fmt.Sprintf(`
+data "clerk_user" "owner" {
+  application_id = clerk_application.test.id
+  environment    = "development"
+  email_address  = %[1]q
+}
+`, ownerEmail)
+	}
+	for _, key := range []string{"admin_delete_unset", "admin_delete_true", "admin_delete_false"} {
+		for i, attrs := range optional {
+			name := fmt.Sprintf("%s_%d", key, i)
+			if attrs != "" {
+				attrs = fmt.Sprintf(attrs, strings.ReplaceAll(name, "_", "-")+"-"+slugSuffix)
+				if ownerEmail != "" {
+					attrs += "\n  created_by              = data.clerk_user.owner.id"
+				}
+			} else if logoPath != "" {
+				attrs = fmt.Sprintf("logo_path = %q", logoPath)
+			}
+			config += fmt.Sprintf(`
+resource "clerk_organization" %[1]q {
+  application_id = clerk_application.test.id
+  environment    = "development"
+  name           = "%[2]s %[1]s"
+  %[3]s
+  %[4]s
+
+  deletion_protection = false
+
+  depends_on = [clerk_environment.test]
+}
+`, name, orgName, adminDelete[key], attrs)
+		}
+	}
+	return config
+}
+
+// testAccClerkOrganizationConfig_metadata writes the metadata with indentation
+// and a key order that differ from what the API returns.
+func testAccClerkOrganizationConfig_metadata(appName, orgName, plan string) string {
+	return testAccClerkOrganizationBase(appName) + fmt.Sprintf(`
+resource "clerk_organization" "test" {
+  application_id = clerk_application.test.id
+  environment    = "development"
+  name           = %[1]q
+
+  public_metadata = <<-EOT
+    {
+      "plan": %[2]q,
+      "features": { "sso": true, "audit_log": false }
+    }
+  EOT
+
+  private_metadata = jsonencode({ billing_id = "cus_123" })
+
+  deletion_protection = false
+
+  depends_on = [clerk_environment.test]
+}
+`, orgName, plan)
+}
+
+func testAccClerkOrganizationConfig_logo(appName, orgName, logoPath string) string {
+	return testAccClerkOrganizationBase(appName) + fmt.Sprintf(`
+resource "clerk_organization" "test" {
+  application_id = clerk_application.test.id
+  environment    = "development"
+  name           = %[1]q
+  logo_path      = %[2]q
+
+  deletion_protection = false
+
+  depends_on = [clerk_environment.test]
+}
+`, orgName, logoPath)
+}
+
+// testAccPNG is a 1x1 transparent PNG.
+var testAccPNG = []byte{
+	0x89, 0x50, 0x4e, 0x47, 0x0d, 0x0a, 0x1a, 0x0a, 0x00, 0x00, 0x00, 0x0d, 0x49, 0x48, 0x44, 0x52,
+	0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x01, 0x08, 0x06, 0x00, 0x00, 0x00, 0x1f, 0x15, 0xc4,
+	0x89, 0x00, 0x00, 0x00, 0x0d, 0x49, 0x44, 0x41, 0x54, 0x78, 0x9c, 0x63, 0x00, 0x01, 0x00, 0x00,
+	0x05, 0x00, 0x01, 0x0d, 0x0a, 0x2d, 0xb4, 0x00, 0x00, 0x00, 0x00, 0x49, 0x45, 0x4e, 0x44, 0xae,
+	0x42, 0x60, 0x82,
+}
+
+func testAccClerkOrganizationConfig_logoSourceURL(appName, orgName, logoURL string) string {
+	return testAccClerkOrganizationBase(appName) + fmt.Sprintf(`
+resource "clerk_organization" "test" {
+  application_id  = clerk_application.test.id
+  environment     = "development"
+  name            = %[1]q
+  logo_source_url = %[2]q
+
+  deletion_protection = false
+
+  depends_on = [clerk_environment.test]
+}
+`, orgName, logoURL)
+}
+
+// testAccOpaquePNG returns a 1x1 opaque PNG whose contents differ from testAccPNG.
+func testAccOpaquePNG(t *testing.T) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, 1, 1))
+	img.Set(0, 0, color.RGBA{R: 0x63, G: 0x66, B: 0xf1, A: 0xff})
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func testAccSHA256(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
 func testAccClerkOrganizationDataSourceConfig_byId(appName, orgName string) string {
 	return testAccClerkOrganizationConfig_basic(appName, orgName) + `
 data "clerk_organization" "test" {
@@ -227,3 +704,15 @@ data "clerk_organization" "test" {
 }
 `
 }
+
+func testAccClerkOrganizationsDataSourceConfig_query(appName, orgName string) string {
+	return testAccClerkOrganizationConfig_basic(appName, orgName) + `
+data "clerk_organizations" "test" {
+  application_id        = clerk_application.test.id
+  environment           = "development"
+  query                 = clerk_organization.test.slug
+  order_by              = "-created_at"
+  include_members_count = true
+}
+`
+}
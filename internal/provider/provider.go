@@ -2,19 +2,36 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"regexp"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/makolabsai/terraform-provider-clerk/internal/client"
 	"github.com/makolabsai/terraform-provider-clerk/internal/datasources"
+	"github.com/makolabsai/terraform-provider-clerk/internal/ephemeralresources"
 	"github.com/makolabsai/terraform-provider-clerk/internal/resources"
 )
 
-var _ provider.Provider = (*ClerkProvider)(nil)
+var (
+	_ provider.Provider                       = (*ClerkProvider)(nil)
+	_ provider.ProviderWithEphemeralResources = (*ClerkProvider)(nil)
+)
+
+// apiVersionPattern matches Clerk API versions, which are release dates.
+var apiVersionPattern = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
 
 // ClerkProvider implements the Terraform provider for Clerk.
 type ClerkProvider struct {
@@ -25,7 +42,15 @@ type ClerkProvider struct {
 
 // ClerkProviderModel describes the provider configuration data model.
 type ClerkProviderModel struct {
-	PlatformAPIKey types.String `tfsdk:"platform_api_key"`
+	PlatformAPIKey    types.String  `tfsdk:"platform_api_key"`
+	BackendSecretKeys types.Map     `tfsdk:"backend_secret_keys"`
+	PlatformAPIURL    types.String  `tfsdk:"platform_api_url"`
+	BackendAPIURL     types.String  `tfsdk:"backend_api_url"`
+	MaxRetries        types.Int64   `tfsdk:"max_retries"`
+	RetryMaxWait      types.String  `tfsdk:"retry_max_wait"`
+	RequestsPerSecond types.Float64 `tfsdk:"requests_per_second"`
+	APIVersion        types.String  `tfsdk:"api_version"`
+	HTTPTimeout       types.String  `tfsdk:"http_timeout"`
 }
 
 // New returns a function that creates a new instance of the Clerk provider.
@@ -58,10 +83,63 @@ func (p *ClerkProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 		Attributes: map[string]schema.Attribute{
 			"platform_api_key": schema.StringAttribute{
 				Description: "The Clerk Platform API key for workspace-level operations. " +
-					"Can also be set via the CLERK_PLATFORM_API_KEY environment variable.",
+					"Can also be set via the CLERK_PLATFORM_API_KEY environment variable. " +
+					"Only required when Platform API resources such as clerk_application are used.",
 				Optional:  true,
 				Sensitive: true,
 			},
+			"backend_secret_keys": schema.MapAttribute{
+				Description: "Backend API secret keys keyed by \"{application_id}/{environment}\". " +
+					"Use this to manage instance-level resources without a Platform API key.",
+				Optional:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+			},
+			"platform_api_url": schema.StringAttribute{
+				Description: "Base URL of the Clerk Platform API. Defaults to https://api.clerk.com/v1. " +
+					"Can also be set via the CLERK_PLATFORM_API_URL environment variable.",
+				Optional: true,
+			},
+			"backend_api_url": schema.StringAttribute{
+				Description: "Base URL of the Clerk Backend API. Defaults to https://api.clerk.com/v1. " +
+					"Can also be set via the CLERK_BACKEND_API_URL environment variable.",
+				Optional: true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Maximum number of times a rate-limited (429) or failed (5xx) request is retried. " +
+					"Non-idempotent requests are only retried on 429. Defaults to 3. Set to 0 to disable retries.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_max_wait": schema.StringAttribute{
+				Description: "Maximum wait between retries as a Go duration string (e.g. \"30s\"). " +
+					"Waits grow exponentially with jitter and honour the Retry-After header up to this limit. Defaults to 30s.",
+				Optional: true,
+			},
+			"http_timeout": schema.StringAttribute{
+				Description: "Time limit for a single HTTP request to Clerk as a Go duration string (e.g. \"60s\"). " +
+					"Each retry gets a fresh timeout. Defaults to 60s. Set to \"0s\" to disable.",
+				Optional: true,
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Description: "Client-side rate limit in requests per second, applied separately to the Platform API key " +
+					"and to each Backend API instance and shared across all resources. Defaults to 10. Set to 0 to disable.",
+				Optional: true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"api_version": schema.StringAttribute{
+				Description: "Pins the Clerk API version (e.g. \"2025-11-10\") sent in the Clerk-API-Version header " +
+					"on every request, so upstream behaviour changes don't take effect until the pin is updated. " +
+					"Can also be set via the CLERK_API_VERSION environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(apiVersionPattern, "must be a date in YYYY-MM-DD format"),
+				},
+			},
 		},
 	}
 }
@@ -75,24 +153,111 @@ func (p *ClerkProvider) Configure(ctx context.Context, req provider.ConfigureReq
 	}
 
 	// Resolve the Platform API key: config takes precedence over env var.
-	platformAPIKey := os.Getenv("CLERK_PLATFORM_API_KEY")
-	if !data.PlatformAPIKey.IsNull() && !data.PlatformAPIKey.IsUnknown() {
-		platformAPIKey = data.PlatformAPIKey.ValueString()
+	platformAPIKey := stringFromConfigOrEnv(data.PlatformAPIKey, "CLERK_PLATFORM_API_KEY")
+
+	var backendSecretKeys map[string]string
+	if !data.BackendSecretKeys.IsNull() && !data.BackendSecretKeys.IsUnknown() {
+		resp.Diagnostics.Append(data.BackendSecretKeys.ElementsAs(ctx, &backendSecretKeys, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
-	if platformAPIKey == "" {
+	if platformAPIKey == "" && len(backendSecretKeys) == 0 {
 		resp.Diagnostics.AddError(
-			"Missing Platform API Key",
-			"The Clerk Platform API key must be set in the provider configuration "+
-				"block (platform_api_key) or via the CLERK_PLATFORM_API_KEY environment variable.",
+			"Missing Clerk Credentials",
+			"Either a Clerk Platform API key must be set in the provider configuration "+
+				"block (platform_api_key) or via the CLERK_PLATFORM_API_KEY environment variable, "+
+				"or Backend API secret keys must be set via backend_secret_keys.",
 		)
 		return
 	}
 
 	clerkClient := client.NewClerkClient(platformAPIKey)
+	clerkClient.UserAgent = client.UserAgent(p.version, req.TerraformVersion)
+
+	apiVersion := stringFromConfigOrEnv(data.APIVersion, "CLERK_API_VERSION")
+	if apiVersion != "" && !apiVersionPattern.MatchString(apiVersion) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_version"),
+			"Invalid Clerk API Version",
+			fmt.Sprintf("Expected a date in YYYY-MM-DD format, got: %q", apiVersion),
+		)
+		return
+	}
+	clerkClient.APIVersion = apiVersion
+
+	// Resolve the API base URLs: config takes precedence over env vars.
+	if v := stringFromConfigOrEnv(data.PlatformAPIURL, "CLERK_PLATFORM_API_URL"); v != "" {
+		clerkClient.PlatformAPIURL = v
+	}
+	clerkClient.BackendAPIURL = stringFromConfigOrEnv(data.BackendAPIURL, "CLERK_BACKEND_API_URL")
+
+	if !data.MaxRetries.IsNull() && !data.MaxRetries.IsUnknown() {
+		clerkClient.MaxRetries = int(data.MaxRetries.ValueInt64())
+	}
+	if !data.RetryMaxWait.IsNull() && !data.RetryMaxWait.IsUnknown() {
+		retryMaxWait, err := time.ParseDuration(data.RetryMaxWait.ValueString())
+		if err != nil || retryMaxWait <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_max_wait"),
+				"Invalid Retry Max Wait",
+				fmt.Sprintf("Expected a positive duration such as \"30s\", got: %q", data.RetryMaxWait.ValueString()),
+			)
+			return
+		}
+		clerkClient.RetryMaxWait = retryMaxWait
+	}
+	if !data.HTTPTimeout.IsNull() && !data.HTTPTimeout.IsUnknown() {
+		httpTimeout, err := time.ParseDuration(data.HTTPTimeout.ValueString())
+		if err != nil || httpTimeout < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("http_timeout"),
+				"Invalid HTTP Timeout",
+				fmt.Sprintf("Expected a non-negative duration such as \"60s\", got: %q", data.HTTPTimeout.ValueString()),
+			)
+			return
+		}
+		clerkClient.HTTPTimeout = httpTimeout
+	}
+	if !data.RequestsPerSecond.IsNull() && !data.RequestsPerSecond.IsUnknown() {
+		clerkClient.RequestsPerSecond = data.RequestsPerSecond.ValueFloat64()
+	}
+
+	for key, secretKey := range backendSecretKeys {
+		appID, environment, ok := strings.Cut(key, "/")
+		if !ok || appID == "" || environment == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("backend_secret_keys").AtMapKey(key),
+				"Invalid Backend Secret Key Entry",
+				fmt.Sprintf("Expected key format: {application_id}/{environment}, got: %q", key),
+			)
+			continue
+		}
+		if err := clerkClient.RegisterBackendClient(appID, environment, secretKey); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("backend_secret_keys").AtMapKey(key),
+				"Invalid Backend Secret Key Entry",
+				fmt.Sprintf("Could not register backend client for %s: %s", key, err.Error()),
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	resp.DataSourceData = clerkClient
 	resp.ResourceData = clerkClient
+	resp.EphemeralResourceData = clerkClient
+}
+
+// stringFromConfigOrEnv returns the configured value if set, otherwise the
+// value of the given environment variable.
+func stringFromConfigOrEnv(value types.String, envVar string) string {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueString()
+	}
+	return os.Getenv(envVar)
 }
 
 func (p *ClerkProvider) Resources(_ context.Context) []func() resource.Resource {
@@ -100,12 +265,23 @@ func (p *ClerkProvider) Resources(_ context.Context) []func() resource.Resource
 		resources.NewApplicationResource,
 		resources.NewEnvironmentResource,
 		resources.NewOrganizationResource,
+		resources.NewDomainVerificationResource,
+	}
+}
+
+func (p *ClerkProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		ephemeralresources.NewInstanceKeysEphemeralResource,
 	}
 }
 
 func (p *ClerkProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		datasources.NewApplicationDataSource,
+		datasources.NewApplicationsDataSource,
 		datasources.NewOrganizationDataSource,
+		datasources.NewOrganizationsDataSource,
+		datasources.NewUserDataSource,
+		datasources.NewUsersDataSource,
 	}
 }
@@ -0,0 +1,76 @@
+package provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// The provider does not manage users, so a freshly created application has
+// none. These tests cover the lookup paths against an empty user pool.
+
+func TestAccClerkUserDataSource_notFound(t *testing.T) {
+	rName := "tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccClerkUserDataSourceConfig_byEmail(rName),
+				ExpectError: regexp.MustCompile(`User Not Found`),
+			},
+		},
+	})
+}
+
+func TestAccClerkUsersDataSource_empty(t *testing.T) {
+	rName := "tf-acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClerkUsersDataSourceConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.clerk_users.test", "users.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccClerkUserDataSourceConfig_byEmail(appName string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "clerk_application" "test" {
+  name                = %[1]q
+  deletion_protection = false
+}
+
+data "clerk_user" "test" {
+  application_id = clerk_application.test.id
+  environment    = "development"
+  email_address  = "nobody+%[1]s@example.com"
+}
+`, appName)
+}
+
+func testAccClerkUsersDataSourceConfig(appName string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "clerk_application" "test" {
+  name                = %[1]q
+  deletion_protection = false
+}
+
+data "clerk_users" "test" {
+  application_id  = clerk_application.test.id
+  environment     = "development"
+  email_addresses = ["nobody+%[1]s@example.com"]
+  order_by        = "-created_at"
+}
+`, appName)
+}
@@ -3,21 +3,27 @@ package resources
 import (
 	"context"
 	"fmt"
+	"slices"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/makolabsai/terraform-provider-clerk/internal/client"
+	"github.com/makolabsai/terraform-provider-clerk/internal/diagnostics"
 )
 
 var (
-	_ resource.Resource                = (*ApplicationResource)(nil)
-	_ resource.ResourceWithImportState = (*ApplicationResource)(nil)
+	_ resource.Resource                 = (*ApplicationResource)(nil)
+	_ resource.ResourceWithImportState  = (*ApplicationResource)(nil)
+	_ resource.ResourceWithUpgradeState = (*ApplicationResource)(nil)
 )
 
 // ApplicationResource manages a Clerk application via the Platform API.
@@ -30,7 +36,10 @@ type ApplicationResourceModel struct {
 	ID                 types.String `tfsdk:"id"`
 	Name               types.String `tfsdk:"name"`
 	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	ExposeSecretKeys   types.Bool   `tfsdk:"expose_secret_keys"`
 	Domain             types.String `tfsdk:"domain"`
+	ProxyPath          types.String `tfsdk:"proxy_path"`
+	DNSRecords         types.List   `tfsdk:"dns_records"`
 	EnvironmentTypes   types.List   `tfsdk:"environment_types"`
 	Template           types.String `tfsdk:"template"`
 	DevInstanceID      types.String `tfsdk:"dev_instance_id"`
@@ -39,6 +48,50 @@ type ApplicationResourceModel struct {
 	ProdInstanceID     types.String `tfsdk:"prod_instance_id"`
 	ProdSecretKey      types.String `tfsdk:"prod_secret_key"`
 	ProdPublishableKey types.String `tfsdk:"prod_publishable_key"`
+	Instances          types.Map    `tfsdk:"instances"`
+	LogoURL            types.String `tfsdk:"logo_url"`
+	HomeURL            types.String `tfsdk:"home_url"`
+	CreatedAt          types.Int64  `tfsdk:"created_at"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// ApplicationInstanceModel describes an entry of the instances map.
+type ApplicationInstanceModel struct {
+	InstanceID     types.String `tfsdk:"instance_id"`
+	PublishableKey types.String `tfsdk:"publishable_key"`
+	SecretKey      types.String `tfsdk:"secret_key"`
+	FrontendAPIURL types.String `tfsdk:"frontend_api_url"`
+}
+
+var applicationInstanceAttrTypes = map[string]attr.Type{
+	"instance_id":      types.StringType,
+	"publishable_key":  types.StringType,
+	"secret_key":       types.StringType,
+	"frontend_api_url": types.StringType,
+}
+
+// DNSRecordModel describes an entry of the dns_records list.
+type DNSRecordModel struct {
+	Host     types.String `tfsdk:"host"`
+	Value    types.String `tfsdk:"value"`
+	Required types.Bool   `tfsdk:"required"`
+}
+
+var dnsRecordAttrTypes = map[string]attr.Type{
+	"host":     types.StringType,
+	"value":    types.StringType,
+	"required": types.BoolType,
+}
+
+// applicationParamPaths maps Platform API request parameters to schema attributes
+// so that API validation errors are reported against the right attribute.
+var applicationParamPaths = map[string]path.Path{
+	"name":              path.Root("name"),
+	"domain":            path.Root("domain"),
+	"proxy_path":        path.Root("proxy_path"),
+	"environment_types": path.Root("environment_types"),
+	"template":          path.Root("template"),
 }
 
 func NewApplicationResource() resource.Resource {
@@ -49,8 +102,9 @@ func (r *ApplicationResource) Metadata(_ context.Context, req resource.MetadataR
 	resp.TypeName = req.ProviderTypeName + "_application"
 }
 
-func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *ApplicationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     1,
 		Description: "Manages a Clerk application. Each application can have multiple instances (development, production) with distinct user pools.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -73,22 +127,62 @@ func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 					boolplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"expose_secret_keys": schema.BoolAttribute{
+				Description: "Whether instance secret keys are stored in the dev_secret_key, prod_secret_key and instances attributes. " +
+					"When false, the keys are kept out of state but still used in memory by dependent resources. Defaults to true.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"domain": schema.StringAttribute{
-				Description: "The domain for the application. Only set at creation time.",
+				Description: "The production domain for the application. Changing it updates the production instance in place.",
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"proxy_path": schema.StringAttribute{
+				Description: "Path on the application's domain that proxies Clerk's Frontend API (e.g. \"/__clerk\").",
+				Optional:    true,
+			},
+			"dns_records": schema.ListNestedAttribute{
+				Description: "DNS records Clerk requires for the production domain. Empty without a production instance.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"host": schema.StringAttribute{
+							Description: "The record's host name.",
+							Computed:    true,
+						},
+						"value": schema.StringAttribute{
+							Description: "The CNAME target the host must point to.",
+							Computed:    true,
+						},
+						"required": schema.BoolAttribute{
+							Description: "Whether the record is required for the domain to work.",
+							Computed:    true,
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.List{
+					listUseStateForUnknownUnlessChanged{paths: []path.Path{
+						path.Root("domain"),
+						path.Root("proxy_path"),
+						path.Root("environment_types"),
+					}},
+				},
+			},
 			"environment_types": schema.ListAttribute{
-				Description: "List of environment types to create instances for (e.g., development, production). Only set at creation time.",
+				Description: "List of environment types to create instances for (e.g., development, production). " +
+					"Adding an environment type creates its instance in place; removing one forces a new resource.",
 				Optional:    true,
 				Computed:    true,
 				ElementType: types.StringType,
 				PlanModifiers: []planmodifier.List{
-					listRequiresReplace{},
+					listRequiresReplaceOnRemoval{},
 				},
 			},
 			"template": schema.StringAttribute{
@@ -102,7 +196,7 @@ func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Description: "The instance ID for the development environment.",
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
+					instanceUseStateForUnknown{},
 				},
 			},
 			"dev_secret_key": schema.StringAttribute{
@@ -110,21 +204,22 @@ func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Computed:    true,
 				Sensitive:   true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
+					instanceUseStateForUnknown{secretKey: true},
+					nullWhenSecretKeysHidden{},
 				},
 			},
 			"dev_publishable_key": schema.StringAttribute{
 				Description: "The publishable key for the development instance.",
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
+					instanceUseStateForUnknown{},
 				},
 			},
 			"prod_instance_id": schema.StringAttribute{
 				Description: "The instance ID for the production environment.",
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
+					instanceUseStateForUnknown{},
 				},
 			},
 			"prod_secret_key": schema.StringAttribute{
@@ -132,16 +227,74 @@ func (r *ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Computed:    true,
 				Sensitive:   true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
+					instanceUseStateForUnknown{secretKey: true},
+					nullWhenSecretKeysHidden{},
 				},
 			},
 			"prod_publishable_key": schema.StringAttribute{
 				Description: "The publishable key for the production instance.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					instanceUseStateForUnknown{},
+				},
+			},
+			"instances": schema.MapNestedAttribute{
+				Description: "The application's instances keyed by environment type (e.g. development, staging, production).",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"instance_id": schema.StringAttribute{
+							Description: "The instance ID.",
+							Computed:    true,
+						},
+						"publishable_key": schema.StringAttribute{
+							Description: "The publishable key for the instance.",
+							Computed:    true,
+						},
+						"secret_key": schema.StringAttribute{
+							Description: "The secret key for the instance. Null when expose_secret_keys is false.",
+							Computed:    true,
+							Sensitive:   true,
+						},
+						"frontend_api_url": schema.StringAttribute{
+							Description: "The Frontend API URL of the instance.",
+							Computed:    true,
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.Map{
+					instancesUseStateForUnknown{},
+				},
+			},
+			"logo_url": schema.StringAttribute{
+				Description: "The URL of the application's logo, if one is set.",
+				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"home_url": schema.StringAttribute{
+				Description: "The application's home URL, if one is set.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.Int64Attribute{
+				Description: "Unix timestamp in milliseconds of when the application was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -170,6 +323,14 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	createReq := client.PlatformCreateApplicationRequest{
 		Name: plan.Name.ValueString(),
 	}
@@ -178,6 +339,10 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 		createReq.Domain = plan.Domain.ValueString()
 	}
 
+	if !plan.ProxyPath.IsNull() && !plan.ProxyPath.IsUnknown() {
+		createReq.ProxyPath = plan.ProxyPath.ValueString()
+	}
+
 	if !plan.Template.IsNull() && !plan.Template.IsUnknown() {
 		createReq.Template = plan.Template.ValueString()
 	}
@@ -194,7 +359,7 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 	// Create the application — the response includes secret keys on create.
 	application, err := r.client.CreateApplication(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Clerk application", err.Error())
+		diagnostics.AddAPIError(&resp.Diagnostics, "Error creating Clerk application", err, applicationParamPaths)
 		return
 	}
 
@@ -203,7 +368,12 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 	if plan.DeletionProtection.IsNull() || plan.DeletionProtection.IsUnknown() {
 		plan.DeletionProtection = types.BoolValue(true)
 	}
-	mapInstancesToState(application.Instances, &plan)
+	if plan.ExposeSecretKeys.IsNull() || plan.ExposeSecretKeys.IsUnknown() {
+		plan.ExposeSecretKeys = types.BoolValue(true)
+	}
+	mapApplicationMetadataToState(application, &plan)
+	resp.Diagnostics.Append(mapInstancesToState(ctx, application.Instances, &plan)...)
+	r.readDomain(ctx, application, &plan, &resp.Diagnostics)
 
 	// Register backend clients for each instance with a secret key.
 	r.registerBackendClients(application.ApplicationID, application.Instances, &resp.Diagnostics)
@@ -218,18 +388,37 @@ func (r *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	application, err := r.client.GetApplication(ctx, state.ID.ValueString(), true)
 	if err != nil {
-		if apiErr, ok := err.(*client.PlatformAPIError); ok && apiErr.StatusCode == 404 {
+		if client.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Error reading Clerk application", err.Error())
+		diagnostics.AddAPIError(&resp.Diagnostics, "Error reading Clerk application", err, nil)
 		return
 	}
 
-	// The API does not return the name — preserve it from state.
-	mapInstancesToState(application.Instances, &state)
+	// Imported resources have no expose_secret_keys value yet.
+	if state.ExposeSecretKeys.IsNull() {
+		state.ExposeSecretKeys = types.BoolValue(true)
+	}
+
+	// Refresh the name so that renames made outside Terraform show up as drift.
+	// Older API responses omit it, in which case the name in state is kept.
+	if application.Name != "" {
+		state.Name = types.StringValue(application.Name)
+	}
+	mapApplicationMetadataToState(application, &state)
+	resp.Diagnostics.Append(mapInstancesToState(ctx, application.Instances, &state)...)
+	r.readDomain(ctx, application, &state, &resp.Diagnostics)
 
 	// Register backend clients for each instance with a secret key.
 	r.registerBackendClients(application.ApplicationID, application.Instances, &resp.Diagnostics)
@@ -238,8 +427,29 @@ func (r *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest
 }
 
 func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan ApplicationResourceModel
+	var plan, state ApplicationResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Add instances for environment types that were added to environment_types.
+	// Removals force replacement, so there is nothing else to reconcile here.
+	r.addInstances(ctx, &plan, state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.updateDomain(ctx, &plan, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -250,18 +460,20 @@ func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 
 	_, err := r.client.UpdateApplication(ctx, plan.ID.ValueString(), updateReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating Clerk application", err.Error())
+		diagnostics.AddAPIError(&resp.Diagnostics, "Error updating Clerk application", err, applicationParamPaths)
 		return
 	}
 
 	// Re-read the application to get fresh instance data.
 	application, err := r.client.GetApplication(ctx, plan.ID.ValueString(), true)
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading Clerk application after update", err.Error())
+		diagnostics.AddAPIError(&resp.Diagnostics, "Error reading Clerk application after update", err, nil)
 		return
 	}
 
-	mapInstancesToState(application.Instances, &plan)
+	mapApplicationMetadataToState(application, &plan)
+	resp.Diagnostics.Append(mapInstancesToState(ctx, application.Instances, &plan)...)
+	r.readDomain(ctx, application, &plan, &resp.Diagnostics)
 
 	// Re-register backend clients in case secrets changed.
 	r.registerBackendClients(plan.ID.ValueString(), application.Instances, &resp.Diagnostics)
@@ -276,6 +488,14 @@ func (r *ApplicationResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if state.DeletionProtection.IsNull() ||
 		state.DeletionProtection.IsUnknown() ||
 		state.DeletionProtection.ValueBool() {
@@ -290,7 +510,7 @@ func (r *ApplicationResource) Delete(ctx context.Context, req resource.DeleteReq
 
 	err := r.client.DeleteApplication(ctx, state.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Error deleting Clerk application", err.Error())
+		diagnostics.AddAPIError(&resp.Diagnostics, "Error deleting Clerk application", err, nil)
 		return
 	}
 }
@@ -300,23 +520,202 @@ func (r *ApplicationResource) ImportState(ctx context.Context, req resource.Impo
 }
 
 // mapInstancesToState maps Platform API instance data to the Terraform resource model.
-func mapInstancesToState(instances []client.PlatformApplicationInstance, state *ApplicationResourceModel) {
+// Secret keys are left null when expose_secret_keys is false.
+func mapInstancesToState(ctx context.Context, instances []client.PlatformApplicationInstance, state *ApplicationResourceModel) diag.Diagnostics {
+	exposeSecretKeys := state.ExposeSecretKeys.IsNull() || state.ExposeSecretKeys.IsUnknown() || state.ExposeSecretKeys.ValueBool()
+	if !exposeSecretKeys {
+		state.DevSecretKey = types.StringNull()
+		state.ProdSecretKey = types.StringNull()
+	}
+
+	instanceModels := make(map[string]ApplicationInstanceModel, len(instances))
 	for _, inst := range instances {
+		model := ApplicationInstanceModel{
+			InstanceID:     types.StringValue(inst.InstanceID),
+			PublishableKey: types.StringValue(inst.PublishableKey),
+			SecretKey:      types.StringNull(),
+			FrontendAPIURL: types.StringNull(),
+		}
+		if url := inst.FrontendAPIURL(); url != "" {
+			model.FrontendAPIURL = types.StringValue(url)
+		}
+		if exposeSecretKeys && inst.SecretKey != "" {
+			model.SecretKey = types.StringValue(inst.SecretKey)
+		}
+		instanceModels[inst.EnvironmentType] = model
+
 		switch inst.EnvironmentType {
 		case "development":
-			state.DevInstanceID = types.StringValue(inst.InstanceID)
-			state.DevPublishableKey = types.StringValue(inst.PublishableKey)
-			if inst.SecretKey != "" {
-				state.DevSecretKey = types.StringValue(inst.SecretKey)
+			state.DevInstanceID = model.InstanceID
+			state.DevPublishableKey = model.PublishableKey
+			if !model.SecretKey.IsNull() {
+				state.DevSecretKey = model.SecretKey
 			}
 		case "production":
-			state.ProdInstanceID = types.StringValue(inst.InstanceID)
-			state.ProdPublishableKey = types.StringValue(inst.PublishableKey)
-			if inst.SecretKey != "" {
-				state.ProdSecretKey = types.StringValue(inst.SecretKey)
+			state.ProdInstanceID = model.InstanceID
+			state.ProdPublishableKey = model.PublishableKey
+			if !model.SecretKey.IsNull() {
+				state.ProdSecretKey = model.SecretKey
+			}
+		}
+	}
+
+	instancesMap, diags := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: applicationInstanceAttrTypes}, instanceModels)
+	state.Instances = instancesMap
+	return diags
+}
+
+// addInstances creates an instance for every planned environment type the
+// application doesn't have yet. Production instances use the planned domain.
+// The application is only read when environment_types gained an element.
+func (r *ApplicationResource) addInstances(ctx context.Context, plan *ApplicationResourceModel, state ApplicationResourceModel, diags *diag.Diagnostics) {
+	if plan.EnvironmentTypes.IsNull() || plan.EnvironmentTypes.IsUnknown() {
+		return
+	}
+
+	var envTypes, stateEnvTypes []string
+	diags.Append(plan.EnvironmentTypes.ElementsAs(ctx, &envTypes, false)...)
+	if !state.EnvironmentTypes.IsNull() && !state.EnvironmentTypes.IsUnknown() {
+		diags.Append(state.EnvironmentTypes.ElementsAs(ctx, &stateEnvTypes, false)...)
+	}
+	if diags.HasError() {
+		return
+	}
+	added := false
+	for _, envType := range envTypes {
+		if !slices.Contains(stateEnvTypes, envType) {
+			added = true
+			break
+		}
+	}
+	if !added {
+		return
+	}
+
+	application, err := r.client.GetApplication(ctx, plan.ID.ValueString(), false)
+	if err != nil {
+		diagnostics.AddAPIError(diags, "Error reading Clerk application", err, nil)
+		return
+	}
+	existing := make(map[string]bool, len(application.Instances))
+	for _, inst := range application.Instances {
+		existing[inst.EnvironmentType] = true
+	}
+
+	for _, envType := range envTypes {
+		if existing[envType] {
+			continue
+		}
+		createReq := client.PlatformCreateInstanceRequest{EnvironmentType: envType}
+		if envType == "production" {
+			if !plan.Domain.IsNull() && !plan.Domain.IsUnknown() {
+				createReq.Domain = plan.Domain.ValueString()
+			}
+			if !plan.ProxyPath.IsNull() && !plan.ProxyPath.IsUnknown() {
+				createReq.ProxyPath = plan.ProxyPath.ValueString()
+			}
+		}
+		if _, err := r.client.CreateApplicationInstance(ctx, plan.ID.ValueString(), createReq); err != nil {
+			diagnostics.AddAPIError(diags, fmt.Sprintf("Error adding %s instance to Clerk application", envType), err, applicationParamPaths)
+			return
+		}
+	}
+}
+
+// updateDomain changes the production domain and proxy path in place when they
+// differ from the plan. Applications without a production instance have no
+// domain to update; the planned domain is used when one is added.
+func (r *ApplicationResource) updateDomain(ctx context.Context, plan *ApplicationResourceModel, diags *diag.Diagnostics) {
+	appID := plan.ID.ValueString()
+
+	domain, err := r.client.GetApplicationDomain(ctx, appID)
+	if err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		diagnostics.AddAPIError(diags, "Error reading Clerk application domain", err, nil)
+		return
+	}
+
+	var updateReq client.PlatformUpdateDomainRequest
+	if !plan.Domain.IsNull() && !plan.Domain.IsUnknown() && plan.Domain.ValueString() != domain.Name {
+		updateReq.Name = plan.Domain.ValueStringPointer()
+	}
+	if !plan.ProxyPath.IsUnknown() && plan.ProxyPath.ValueString() != domain.ProxyPath {
+		proxyPath := plan.ProxyPath.ValueString()
+		updateReq.ProxyPath = &proxyPath
+	}
+	if updateReq.Name == nil && updateReq.ProxyPath == nil {
+		return
+	}
+
+	if _, err := r.client.UpdateApplicationDomain(ctx, appID, updateReq); err != nil {
+		diagnostics.AddAPIError(diags, "Error updating Clerk application domain", err, applicationParamPaths)
+	}
+}
+
+// readDomain maps the production domain to domain, proxy_path and dns_records.
+// Without a production instance the configured domain is kept and there are no
+// DNS records.
+func (r *ApplicationResource) readDomain(ctx context.Context, application *client.PlatformApplicationResponse, state *ApplicationResourceModel, diags *diag.Diagnostics) {
+	records := []DNSRecordModel{}
+	if state.Domain.IsUnknown() {
+		state.Domain = types.StringNull()
+	}
+
+	if hasInstance(application.Instances, "production") {
+		domain, err := r.client.GetApplicationDomain(ctx, application.ApplicationID)
+		switch {
+		case client.IsNotFound(err):
+		case err != nil:
+			diagnostics.AddAPIError(diags, "Error reading Clerk application domain", err, nil)
+			return
+		default:
+			state.Domain = types.StringValue(domain.Name)
+			state.ProxyPath = types.StringNull()
+			if domain.ProxyPath != "" {
+				state.ProxyPath = types.StringValue(domain.ProxyPath)
+			}
+			for _, record := range domain.CNAMETargets {
+				records = append(records, DNSRecordModel{
+					Host:     types.StringValue(record.Host),
+					Value:    types.StringValue(record.Value),
+					Required: types.BoolValue(record.Required),
+				})
 			}
 		}
 	}
+
+	recordsList, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: dnsRecordAttrTypes}, records)
+	diags.Append(d...)
+	state.DNSRecords = recordsList
+}
+
+// mapApplicationMetadataToState maps the application's logo, home URL and
+// creation time to the Terraform model. Empty values are stored as null.
+func mapApplicationMetadataToState(application *client.PlatformApplicationResponse, state *ApplicationResourceModel) {
+	state.LogoURL = types.StringNull()
+	if application.LogoURL != "" {
+		state.LogoURL = types.StringValue(application.LogoURL)
+	}
+	state.HomeURL = types.StringNull()
+	if application.HomeURL != "" {
+		state.HomeURL = types.StringValue(application.HomeURL)
+	}
+	state.CreatedAt = types.Int64Null()
+	if application.CreatedAt != 0 {
+		state.CreatedAt = types.Int64Value(application.CreatedAt)
+	}
+}
+
+// hasInstance reports whether instances include one of the given environment type.
+func hasInstance(instances []client.PlatformApplicationInstance, environmentType string) bool {
+	for _, inst := range instances {
+		if inst.EnvironmentType == environmentType {
+			return true
+		}
+	}
+	return false
 }
 
 // registerBackendClients registers Backend API clients for instances that have secret keys.
@@ -336,18 +735,19 @@ func (r *ApplicationResource) registerBackendClients(appID string, instances []c
 	}
 }
 
-// listRequiresReplace is a plan modifier that forces replacement when a list attribute changes.
-type listRequiresReplace struct{}
+// listRequiresReplaceOnRemoval is a plan modifier that forces replacement when
+// an element is removed from a list attribute. Added elements are applied in place.
+type listRequiresReplaceOnRemoval struct{}
 
-func (m listRequiresReplace) Description(_ context.Context) string {
-	return "If the value of this attribute changes, Terraform will destroy and recreate the resource."
+func (m listRequiresReplaceOnRemoval) Description(_ context.Context) string {
+	return "If an element is removed from this attribute, Terraform will destroy and recreate the resource."
 }
 
-func (m listRequiresReplace) MarkdownDescription(ctx context.Context) string {
+func (m listRequiresReplaceOnRemoval) MarkdownDescription(ctx context.Context) string {
 	return m.Description(ctx)
 }
 
-func (m listRequiresReplace) PlanModifyList(_ context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse) {
+func (m listRequiresReplaceOnRemoval) PlanModifyList(_ context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse) {
 	if req.PlanValue.IsUnknown() {
 		resp.PlanValue = req.StateValue
 		return
@@ -356,7 +756,162 @@ func (m listRequiresReplace) PlanModifyList(_ context.Context, req planmodifier.
 		return
 	}
 
-	if !req.PlanValue.Equal(req.StateValue) {
-		resp.RequiresReplace = true
+	planned := make(map[string]bool, len(req.PlanValue.Elements()))
+	for _, elem := range req.PlanValue.Elements() {
+		planned[elem.String()] = true
+	}
+	for _, elem := range req.StateValue.Elements() {
+		if !planned[elem.String()] {
+			resp.RequiresReplace = true
+			return
+		}
 	}
 }
+
+// listUseStateForUnknownUnlessChanged is a plan modifier that keeps the prior
+// value of a computed list in the plan, like UseStateForUnknown, unless one of
+// the attributes it is derived from changes.
+type listUseStateForUnknownUnlessChanged struct {
+	paths []path.Path
+}
+
+func (m listUseStateForUnknownUnlessChanged) Description(_ context.Context) string {
+	return "Once set, the value of this attribute in state will not change unless the attributes it depends on change."
+}
+
+func (m listUseStateForUnknownUnlessChanged) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m listUseStateForUnknownUnlessChanged) PlanModifyList(ctx context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse) {
+	if req.StateValue.IsNull() || !req.PlanValue.IsUnknown() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, p := range m.paths {
+		var planValue, stateValue attr.Value
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, p, &planValue)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, p, &stateValue)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if planValue.IsUnknown() || !planValue.Equal(stateValue) {
+			return
+		}
+	}
+
+	resp.PlanValue = req.StateValue
+}
+
+// nullWhenSecretKeysHidden is a plan modifier that plans a secret key attribute
+// as null when expose_secret_keys is false, so turning it off removes the keys
+// from state on the next apply.
+type nullWhenSecretKeysHidden struct{}
+
+func (m nullWhenSecretKeysHidden) Description(_ context.Context) string {
+	return "Null when expose_secret_keys is false."
+}
+
+func (m nullWhenSecretKeysHidden) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m nullWhenSecretKeysHidden) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	var exposeSecretKeys types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("expose_secret_keys"), &exposeSecretKeys)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !exposeSecretKeys.IsNull() && !exposeSecretKeys.IsUnknown() && !exposeSecretKeys.ValueBool() {
+		resp.PlanValue = types.StringNull()
+	}
+}
+
+// instanceUseStateForUnknown is a plan modifier for the attributes of a single
+// instance, such as prod_instance_id. It keeps the prior value in the plan, like
+// UseStateForUnknown, unless environment_types changes: adding an environment
+// type turns a null prior value into a real one. Secret keys are also planned
+// as unknown when expose_secret_keys is true and the prior key is null, e.g.
+// because the keys were hidden until now.
+type instanceUseStateForUnknown struct {
+	secretKey bool
+}
+
+func (m instanceUseStateForUnknown) Description(_ context.Context) string {
+	return "Once set, the value of this attribute in state will not change unless environment_types changes."
+}
+
+func (m instanceUseStateForUnknown) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m instanceUseStateForUnknown) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.State.Raw.IsNull() || !req.PlanValue.IsUnknown() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var planEnvTypes, stateEnvTypes types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("environment_types"), &planEnvTypes)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("environment_types"), &stateEnvTypes)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !planEnvTypes.Equal(stateEnvTypes) {
+		return
+	}
+
+	if m.secretKey {
+		var exposeSecretKeys types.Bool
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("expose_secret_keys"), &exposeSecretKeys)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if exposeSecretKeys.IsUnknown() || (exposeSecretKeys.ValueBool() && req.StateValue.IsNull()) {
+			return
+		}
+	}
+
+	resp.PlanValue = req.StateValue
+}
+
+// instancesUseStateForUnknown is a plan modifier that keeps the prior instances
+// map in the plan, like UseStateForUnknown, unless expose_secret_keys or
+// environment_types change and the map will change with them.
+type instancesUseStateForUnknown struct{}
+
+func (m instancesUseStateForUnknown) Description(_ context.Context) string {
+	return "Once set, the value of this attribute in state will not change unless expose_secret_keys or environment_types change."
+}
+
+func (m instancesUseStateForUnknown) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m instancesUseStateForUnknown) PlanModifyMap(ctx context.Context, req planmodifier.MapRequest, resp *planmodifier.MapResponse) {
+	if req.StateValue.IsNull() || !req.PlanValue.IsUnknown() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var planExpose, stateExpose types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("expose_secret_keys"), &planExpose)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("expose_secret_keys"), &stateExpose)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if planExpose.IsUnknown() || !planExpose.Equal(stateExpose) {
+		return
+	}
+
+	var planEnvTypes, stateEnvTypes types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("environment_types"), &planEnvTypes)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("environment_types"), &stateEnvTypes)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !planEnvTypes.Equal(stateEnvTypes) {
+		return
+	}
+
+	resp.PlanValue = req.StateValue
+}
@@ -0,0 +1,149 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// instanceTestSchema holds the attributes the instance plan modifiers read.
+var instanceTestSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"environment_types":  schema.ListAttribute{Optional: true, Computed: true, ElementType: types.StringType},
+		"expose_secret_keys": schema.BoolAttribute{Optional: true, Computed: true},
+		"prod_instance_id":   schema.StringAttribute{Computed: true},
+		"prod_secret_key":    schema.StringAttribute{Computed: true, Sensitive: true},
+	},
+}
+
+// instanceTestValue builds a value of instanceTestSchema. A nil key leaves the
+// instance attributes null; an empty one makes them unknown.
+func instanceTestValue(envTypes []string, expose bool, key *string) tftypes.Value {
+	objectType := instanceTestSchema.Type().TerraformType(context.Background()).(tftypes.Object)
+
+	envValues := make([]tftypes.Value, len(envTypes))
+	for i, envType := range envTypes {
+		envValues[i] = tftypes.NewValue(tftypes.String, envType)
+	}
+	keyValue := tftypes.NewValue(tftypes.String, nil)
+	switch {
+	case key != nil && *key == "":
+		keyValue = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	case key != nil:
+		keyValue = tftypes.NewValue(tftypes.String, *key)
+	}
+
+	return tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"environment_types":  tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, envValues),
+		"expose_secret_keys": tftypes.NewValue(tftypes.Bool, expose),
+		"prod_instance_id":   keyValue,
+		"prod_secret_key":    keyValue,
+	})
+}
+
+func ptr(s string) *string { return &s }
+
+func TestInstanceUseStateForUnknown(t *testing.T) {
+	devOnly := []string{"development"}
+	devAndProd := []string{"development", "production"}
+
+	tests := []struct {
+		name     string
+		modifier instanceUseStateForUnknown
+		attr     string
+		state    tftypes.Value
+		plan     tftypes.Value
+		want     types.String
+	}{
+		{
+			name:  "unchanged null is kept",
+			state: instanceTestValue(devOnly, true, nil),
+			plan:  instanceTestValue(devOnly, true, ptr("")),
+			want:  types.StringNull(),
+		},
+		{
+			name:  "unchanged value is kept",
+			state: instanceTestValue(devAndProd, true, ptr("ins_prod")),
+			plan:  instanceTestValue(devAndProd, true, ptr("")),
+			want:  types.StringValue("ins_prod"),
+		},
+		{
+			name:  "added environment type is unknown",
+			state: instanceTestValue(devOnly, true, nil),
+			plan:  instanceTestValue(devAndProd, true, ptr("")),
+			want:  types.StringUnknown(),
+		},
+		{
+			name:  "create is unknown",
+			state: tftypes.NewValue(instanceTestSchema.Type().TerraformType(context.Background()), nil),
+			plan:  instanceTestValue(devAndProd, true, ptr("")),
+			want:  types.StringUnknown(),
+		},
+		{
+			name:     "exposed secret key is kept",
+			modifier: instanceUseStateForUnknown{secretKey: true},
+			attr:     "prod_secret_key",
+			state:    instanceTestValue(devAndProd, true, ptr("sk_prod")),
+			plan:     instanceTestValue(devAndProd, true, ptr("")),
+			want:     types.StringValue("sk_prod"),
+		},
+		{
+			name:     "exposing hidden secret key is unknown",
+			modifier: instanceUseStateForUnknown{secretKey: true},
+			attr:     "prod_secret_key",
+			state:    instanceTestValue(devAndProd, false, nil),
+			plan:     instanceTestValue(devAndProd, true, ptr("")),
+			want:     types.StringUnknown(),
+		},
+		{
+			name:     "hidden secret key stays null",
+			modifier: instanceUseStateForUnknown{secretKey: true},
+			attr:     "prod_secret_key",
+			state:    instanceTestValue(devAndProd, false, nil),
+			plan:     instanceTestValue(devAndProd, false, ptr("")),
+			want:     types.StringNull(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			attr := tt.attr
+			if attr == "" {
+				attr = "prod_instance_id"
+			}
+			state := tfsdk.State{Schema: instanceTestSchema, Raw: tt.state}
+			plan := tfsdk.Plan{Schema: instanceTestSchema, Raw: tt.plan}
+
+			stateValue := types.StringNull()
+			if !tt.state.IsNull() {
+				state.GetAttribute(ctx, path.Root(attr), &stateValue)
+			}
+
+			req := planmodifier.StringRequest{
+				Path:        path.Root(attr),
+				Config:      tfsdk.Config{Schema: instanceTestSchema, Raw: tt.plan},
+				ConfigValue: types.StringNull(),
+				Plan:        plan,
+				PlanValue:   types.StringUnknown(),
+				State:       state,
+				StateValue:  stateValue,
+			}
+			resp := &planmodifier.StringResponse{PlanValue: req.PlanValue}
+
+			tt.modifier.PlanModifyString(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if !resp.PlanValue.Equal(tt.want) {
+				t.Errorf("expected %s, got %s", tt.want, resp.PlanValue)
+			}
+		})
+	}
+}
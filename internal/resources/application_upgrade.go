@@ -0,0 +1,116 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/makolabsai/terraform-provider-clerk/internal/client"
+)
+
+// applicationResourceModelV0 is the clerk_application state before the
+// instances map was added.
+type applicationResourceModelV0 struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	ExposeSecretKeys   types.Bool   `tfsdk:"expose_secret_keys"`
+	Domain             types.String `tfsdk:"domain"`
+	EnvironmentTypes   types.List   `tfsdk:"environment_types"`
+	Template           types.String `tfsdk:"template"`
+	DevInstanceID      types.String `tfsdk:"dev_instance_id"`
+	DevSecretKey       types.String `tfsdk:"dev_secret_key"`
+	DevPublishableKey  types.String `tfsdk:"dev_publishable_key"`
+	ProdInstanceID     types.String `tfsdk:"prod_instance_id"`
+	ProdSecretKey      types.String `tfsdk:"prod_secret_key"`
+	ProdPublishableKey types.String `tfsdk:"prod_publishable_key"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *ApplicationResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":                   schema.StringAttribute{Computed: true},
+					"name":                 schema.StringAttribute{Required: true},
+					"deletion_protection":  schema.BoolAttribute{Optional: true, Computed: true},
+					"expose_secret_keys":   schema.BoolAttribute{Optional: true, Computed: true},
+					"domain":               schema.StringAttribute{Optional: true, Computed: true},
+					"environment_types":    schema.ListAttribute{Optional: true, Computed: true, ElementType: types.StringType},
+					"template":             schema.StringAttribute{Optional: true},
+					"dev_instance_id":      schema.StringAttribute{Computed: true},
+					"dev_secret_key":       schema.StringAttribute{Computed: true, Sensitive: true},
+					"dev_publishable_key":  schema.StringAttribute{Computed: true},
+					"prod_instance_id":     schema.StringAttribute{Computed: true},
+					"prod_secret_key":      schema.StringAttribute{Computed: true, Sensitive: true},
+					"prod_publishable_key": schema.StringAttribute{Computed: true},
+				},
+				Blocks: map[string]schema.Block{
+					"timeouts": timeouts.Block(ctx, timeouts.Opts{
+						Create: true,
+						Read:   true,
+						Update: true,
+						Delete: true,
+					}),
+				},
+			},
+			StateUpgrader: upgradeApplicationStateV0,
+		},
+	}
+}
+
+// upgradeApplicationStateV0 builds the instances map from the dev_* and prod_*
+// attributes so that the first plan after upgrading is empty.
+func upgradeApplicationStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior applicationResourceModelV0
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	upgraded := ApplicationResourceModel{
+		ID:                 prior.ID,
+		Name:               prior.Name,
+		DeletionProtection: prior.DeletionProtection,
+		ExposeSecretKeys:   prior.ExposeSecretKeys,
+		Domain:             prior.Domain,
+		EnvironmentTypes:   prior.EnvironmentTypes,
+		Template:           prior.Template,
+		DevInstanceID:      prior.DevInstanceID,
+		DevSecretKey:       prior.DevSecretKey,
+		DevPublishableKey:  prior.DevPublishableKey,
+		ProdInstanceID:     prior.ProdInstanceID,
+		ProdSecretKey:      prior.ProdSecretKey,
+		ProdPublishableKey: prior.ProdPublishableKey,
+		Timeouts:           prior.Timeouts,
+	}
+
+	var instances []client.PlatformApplicationInstance
+	if !prior.DevInstanceID.IsNull() {
+		instances = append(instances, client.PlatformApplicationInstance{
+			InstanceID:      prior.DevInstanceID.ValueString(),
+			EnvironmentType: "development",
+			PublishableKey:  prior.DevPublishableKey.ValueString(),
+			SecretKey:       prior.DevSecretKey.ValueString(),
+		})
+	}
+	if !prior.ProdInstanceID.IsNull() {
+		instances = append(instances, client.PlatformApplicationInstance{
+			InstanceID:      prior.ProdInstanceID.ValueString(),
+			EnvironmentType: "production",
+			PublishableKey:  prior.ProdPublishableKey.ValueString(),
+			SecretKey:       prior.ProdSecretKey.ValueString(),
+		})
+	}
+	upgraded.DNSRecords = types.ListNull(types.ObjectType{AttrTypes: dnsRecordAttrTypes})
+	resp.Diagnostics.Append(mapInstancesToState(ctx, instances, &upgraded)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &upgraded)...)
+}
@@ -0,0 +1,267 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/makolabsai/terraform-provider-clerk/internal/client"
+	"github.com/makolabsai/terraform-provider-clerk/internal/diagnostics"
+)
+
+var _ resource.Resource = (*DomainVerificationResource)(nil)
+
+// defaultVerificationTimeout bounds how long Create waits for verification when
+// no timeouts block is configured. DNS propagation and SSL issuance are slow.
+const defaultVerificationTimeout = 45 * time.Minute
+
+// DomainVerificationResource waits until the production domain of a Clerk
+// application has verified DNS records and, optionally, an issued SSL
+// certificate. It manages no remote object; it only gates dependent resources.
+type DomainVerificationResource struct {
+	client *client.ClerkClient
+}
+
+// DomainVerificationResourceModel describes the Terraform resource data model.
+type DomainVerificationResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	ApplicationID types.String `tfsdk:"application_id"`
+	Domain        types.String `tfsdk:"domain"`
+	WaitForSSL    types.Bool   `tfsdk:"wait_for_ssl"`
+	PollInterval  types.String `tfsdk:"poll_interval"`
+	Status        types.String `tfsdk:"status"`
+	DNSVerified   types.Bool   `tfsdk:"dns_verified"`
+	SSLVerified   types.Bool   `tfsdk:"ssl_verified"`
+	MailVerified  types.Bool   `tfsdk:"mail_verified"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewDomainVerificationResource() resource.Resource {
+	return &DomainVerificationResource{}
+}
+
+func (r *DomainVerificationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_verification"
+}
+
+func (r *DomainVerificationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Waits until the production domain of a Clerk application is verified. " +
+			"Create polls the domain status until DNS (and, by default, SSL) verification completes or the create timeout elapses. " +
+			"Destroying this resource has no effect on the domain.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The application ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"application_id": schema.StringAttribute{
+				Description: "The Clerk application ID whose production domain to wait for.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				Description: "The production domain being verified. Changing it waits for verification again; " +
+					"set it to clerk_application.domain so that domain changes are re-verified.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_ssl": schema.BoolAttribute{
+				Description: "Whether to also wait for the SSL certificate to be issued. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"poll_interval": schema.StringAttribute{
+				Description: "How often to check the domain status, as a Go duration string. Defaults to \"30s\".",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("30s"),
+				Validators: []validator.String{
+					positiveDuration(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "The overall verification status of the domain.",
+				Computed:    true,
+			},
+			"dns_verified": schema.BoolAttribute{
+				Description: "Whether Clerk has verified the domain's DNS records.",
+				Computed:    true,
+			},
+			"ssl_verified": schema.BoolAttribute{
+				Description: "Whether the domain's SSL certificate has been issued.",
+				Computed:    true,
+			},
+			"mail_verified": schema.BoolAttribute{
+				Description: "Whether the domain's mail records have been verified.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *DomainVerificationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clerkClient, ok := req.ProviderData.(*client.ClerkClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.ClerkClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = clerkClient
+}
+
+func (r *DomainVerificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DomainVerificationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultVerificationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	pollInterval, err := time.ParseDuration(plan.PollInterval.ValueString())
+	if err != nil || pollInterval <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("poll_interval"),
+			"Invalid Poll Interval",
+			fmt.Sprintf("Expected a positive duration such as \"30s\", got: %q", plan.PollInterval.ValueString()),
+		)
+		return
+	}
+
+	appID := plan.ApplicationID.ValueString()
+	waitForSSL := plan.WaitForSSL.ValueBool()
+
+	for {
+		status, err := r.client.GetApplicationDomainStatus(ctx, appID)
+		if err != nil {
+			// A single attempt timing out also returns context.DeadlineExceeded,
+			// so only report a timeout when the create timeout itself elapsed.
+			if ctx.Err() != nil {
+				resp.Diagnostics.AddError(
+					"Timed out waiting for domain verification",
+					fmt.Sprintf("The production domain of application %s was not verified within %s. "+
+						"Check that the records in clerk_application.dns_records exist in your DNS provider.", appID, createTimeout),
+				)
+				return
+			}
+			diagnostics.AddAPIError(&resp.Diagnostics, "Error reading Clerk domain status", err, nil)
+			return
+		}
+
+		mapDomainStatusToState(status, &plan)
+		if plan.DNSVerified.ValueBool() && (!waitForSSL || plan.SSLVerified.ValueBool()) {
+			break
+		}
+
+		tflog.Info(ctx, "Waiting for Clerk domain verification", map[string]any{
+			"application_id": appID,
+			"dns":            status.DNS.Status,
+			"ssl":            status.SSL.Status,
+		})
+
+		timer := time.NewTimer(pollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			resp.Diagnostics.AddError(
+				"Timed out waiting for domain verification",
+				fmt.Sprintf("The production domain of application %s was not verified within %s "+
+					"(DNS: %s, SSL: %s). Check that the records in clerk_application.dns_records exist in your DNS provider.",
+					appID, createTimeout, status.DNS.Status, status.SSL.Status),
+			)
+			return
+		case <-timer.C:
+		}
+	}
+
+	plan.ID = types.StringValue(appID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *DomainVerificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DomainVerificationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	status, err := r.client.GetApplicationDomainStatus(ctx, state.ApplicationID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		diagnostics.AddAPIError(&resp.Diagnostics, "Error reading Clerk domain status", err, nil)
+		return
+	}
+
+	mapDomainStatusToState(status, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *DomainVerificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Only wait_for_ssl, poll_interval and timeouts can change in place, and
+	// they only affect the next wait.
+	var plan, state DomainVerificationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Status = state.Status
+	plan.DNSVerified = state.DNSVerified
+	plan.SSLVerified = state.SSLVerified
+	plan.MailVerified = state.MailVerified
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *DomainVerificationResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// Nothing to delete: the domain belongs to clerk_application.
+}
+
+// mapDomainStatusToState maps a Platform API domain status to the Terraform model.
+func mapDomainStatusToState(status *client.PlatformDomainStatusResponse, state *DomainVerificationResourceModel) {
+	state.Status = types.StringValue(status.Status)
+	state.DNSVerified = types.BoolValue(status.DNS.Status == client.DomainStatusComplete)
+	state.SSLVerified = types.BoolValue(status.SSL.Status == client.DomainStatusComplete)
+	state.MailVerified = types.BoolValue(status.Mail.Status == client.DomainStatusComplete)
+}
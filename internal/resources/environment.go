@@ -3,9 +3,12 @@ package resources
 import (
 	"context"
 	"fmt"
+	"maps"
 	"strings"
 
+	"github.com/clerk/clerk-sdk-go/v2"
 	"github.com/clerk/clerk-sdk-go/v2/instancesettings"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -18,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/makolabsai/terraform-provider-clerk/internal/client"
+	"github.com/makolabsai/terraform-provider-clerk/internal/diagnostics"
 )
 
 var (
@@ -51,6 +55,8 @@ type EnvironmentResourceModel struct {
 
 	// Organization settings (PATCH /instance/organization_settings)
 	OrganizationSettings types.Object `tfsdk:"organization_settings"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // RestrictionsModel maps the restrictions block.
@@ -66,11 +72,15 @@ type RestrictionsModel struct {
 type OrganizationSettingsModel struct {
 	Enabled                types.Bool   `tfsdk:"enabled"`
 	MaxAllowedMemberships  types.Int64  `tfsdk:"max_allowed_memberships"`
+	CreatorRole            types.String `tfsdk:"creator_role"`
 	CreatorRoleID          types.String `tfsdk:"creator_role_id"`
+	CreatorRoleKey         types.String `tfsdk:"creator_role_key"`
 	AdminDeleteEnabled     types.Bool   `tfsdk:"admin_delete_enabled"`
 	DomainsEnabled         types.Bool   `tfsdk:"domains_enabled"`
 	DomainsEnrollmentModes types.List   `tfsdk:"domains_enrollment_modes"`
+	DomainsDefaultRole     types.String `tfsdk:"domains_default_role"`
 	DomainsDefaultRoleID   types.String `tfsdk:"domains_default_role_id"`
+	DomainsDefaultRoleKey  types.String `tfsdk:"domains_default_role_key"`
 }
 
 var restrictionsAttrTypes = map[string]attr.Type{
@@ -84,11 +94,46 @@ var restrictionsAttrTypes = map[string]attr.Type{
 var orgSettingsAttrTypes = map[string]attr.Type{
 	"enabled":                  types.BoolType,
 	"max_allowed_memberships":  types.Int64Type,
+	"creator_role":             types.StringType,
 	"creator_role_id":          types.StringType,
+	"creator_role_key":         types.StringType,
 	"admin_delete_enabled":     types.BoolType,
 	"domains_enabled":          types.BoolType,
 	"domains_enrollment_modes": types.ListType{ElemType: types.StringType},
+	"domains_default_role":     types.StringType,
 	"domains_default_role_id":  types.StringType,
+	"domains_default_role_key": types.StringType,
+}
+
+// instanceSettingsParamPaths, restrictionsParamPaths and orgSettingsParamPaths map
+// Backend API request parameters to schema attributes so that API validation
+// errors are reported against the right attribute.
+var instanceSettingsParamPaths = map[string]path.Path{
+	"test_mode":                     path.Root("test_mode"),
+	"hibp":                          path.Root("hibp"),
+	"enhanced_email_deliverability": path.Root("enhanced_email_deliverability"),
+	"support_email":                 path.Root("support_email"),
+	"clerk_js_version":              path.Root("clerk_js_version"),
+	"url_based_session_syncing":     path.Root("url_based_session_syncing"),
+	"development_origin":            path.Root("development_origin"),
+}
+
+var restrictionsParamPaths = map[string]path.Path{
+	"allowlist":                       path.Root("restrictions").AtName("allowlist"),
+	"blocklist":                       path.Root("restrictions").AtName("blocklist"),
+	"block_email_subaddresses":        path.Root("restrictions").AtName("block_email_subaddresses"),
+	"block_disposable_email_domains":  path.Root("restrictions").AtName("block_disposable_email_domains"),
+	"ignore_dots_for_gmail_addresses": path.Root("restrictions").AtName("ignore_dots_for_gmail_addresses"),
+}
+
+var orgSettingsParamPaths = map[string]path.Path{
+	"enabled":                  path.Root("organization_settings").AtName("enabled"),
+	"max_allowed_memberships":  path.Root("organization_settings").AtName("max_allowed_memberships"),
+	"creator_role_id":          path.Root("organization_settings").AtName("creator_role_id"),
+	"admin_delete_enabled":     path.Root("organization_settings").AtName("admin_delete_enabled"),
+	"domains_enabled":          path.Root("organization_settings").AtName("domains_enabled"),
+	"domains_enrollment_modes": path.Root("organization_settings").AtName("domains_enrollment_modes"),
+	"domains_default_role_id":  path.Root("organization_settings").AtName("domains_default_role_id"),
 }
 
 func NewEnvironmentResource() resource.Resource {
@@ -99,7 +144,7 @@ func (r *EnvironmentResource) Metadata(_ context.Context, req resource.MetadataR
 	resp.TypeName = req.ProviderTypeName + "_environment"
 }
 
-func (r *EnvironmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *EnvironmentResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Configures a Clerk instance's settings (development or production). " +
 			"The instance is auto-created by Clerk when the application is created; this resource manages its configuration. " +
@@ -120,10 +165,10 @@ func (r *EnvironmentResource) Schema(_ context.Context, _ resource.SchemaRequest
 				},
 			},
 			"environment": schema.StringAttribute{
-				Description: "The environment type: \"development\" or \"production\".",
+				Description: "The environment type, e.g. \"development\", \"staging\" or \"production\".",
 				Required:    true,
 				Validators: []validator.String{
-					stringvalidator.OneOf("development", "production"),
+					stringvalidator.LengthAtLeast(1),
 				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
@@ -217,11 +262,23 @@ func (r *EnvironmentResource) Schema(_ context.Context, _ resource.SchemaRequest
 						Optional:    true,
 						Computed:    true,
 					},
+					"creator_role": schema.StringAttribute{
+						Description: "Role assigned to organization creators, given as a role key (e.g. \"org:admin\") or role ID.",
+						Optional:    true,
+						Computed:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("creator_role_id")),
+						},
+					},
 					"creator_role_id": schema.StringAttribute{
-						Description: "Role ID assigned to organization creators.",
+						Description: "Role ID assigned to organization creators. Prefer creator_role, which also accepts role keys.",
 						Optional:    true,
 						Computed:    true,
 					},
+					"creator_role_key": schema.StringAttribute{
+						Description: "Role key assigned to organization creators, as reported by the API.",
+						Computed:    true,
+					},
 					"admin_delete_enabled": schema.BoolAttribute{
 						Description: "Whether organization admins can delete the organization.",
 						Optional:    true,
@@ -238,14 +295,34 @@ func (r *EnvironmentResource) Schema(_ context.Context, _ resource.SchemaRequest
 						Computed:    true,
 						ElementType: types.StringType,
 					},
+					"domains_default_role": schema.StringAttribute{
+						Description: "Default role for domain-enrolled members, given as a role key (e.g. \"org:member\") or role ID.",
+						Optional:    true,
+						Computed:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("domains_default_role_id")),
+						},
+					},
 					"domains_default_role_id": schema.StringAttribute{
-						Description: "Default role ID for domain-enrolled members.",
+						Description: "Default role ID for domain-enrolled members. Prefer domains_default_role, which also accepts role keys.",
 						Optional:    true,
 						Computed:    true,
 					},
+					"domains_default_role_key": schema.StringAttribute{
+						Description: "Default role key for domain-enrolled members, as reported by the API.",
+						Computed:    true,
+					},
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -273,6 +350,14 @@ func (r *EnvironmentResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	appID := plan.ApplicationID.ValueString()
 	env := plan.Environment.ValueString()
 	plan.ID = types.StringValue(appID + "/" + env)
@@ -293,6 +378,14 @@ func (r *EnvironmentResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// The Clerk Backend API does not provide GET endpoints for instance settings.
 	// We preserve the current state as-is. Drift from dashboard changes won't be detected.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -305,6 +398,14 @@ func (r *EnvironmentResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	r.applySettings(ctx, &plan, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
@@ -320,6 +421,14 @@ func (r *EnvironmentResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	appID := state.ApplicationID.ValueString()
 	env := state.Environment.ValueString()
 
@@ -383,14 +492,6 @@ func (r *EnvironmentResource) ImportState(ctx context.Context, req resource.Impo
 		return
 	}
 
-	if parts[1] != "development" && parts[1] != "production" {
-		resp.Diagnostics.AddError(
-			"Invalid Environment",
-			fmt.Sprintf("Environment must be \"development\" or \"production\", got: %q", parts[1]),
-		)
-		return
-	}
-
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_id"), parts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment"), parts[1])...)
@@ -463,7 +564,7 @@ func (r *EnvironmentResource) applyInstanceSettings(ctx context.Context, appID,
 
 	err := r.client.UpdateInstanceSettings(ctx, appID, env, params)
 	if err != nil {
-		diags.AddError("Error updating instance settings", err.Error())
+		diagnostics.AddAPIError(diags, "Error updating instance settings", err, instanceSettingsParamPaths)
 	}
 }
 
@@ -502,7 +603,7 @@ func (r *EnvironmentResource) applyRestrictions(ctx context.Context, appID, env
 
 	result, err := r.client.UpdateInstanceRestrictions(ctx, appID, env, params)
 	if err != nil {
-		diags.AddError("Error updating instance restrictions", err.Error())
+		diagnostics.AddAPIError(diags, "Error updating instance restrictions", err, restrictionsParamPaths)
 		return
 	}
 
@@ -538,9 +639,11 @@ func (r *EnvironmentResource) applyOrganizationSettings(ctx context.Context, app
 		v := orgSettings.MaxAllowedMemberships.ValueInt64()
 		params.MaxAllowedMemberships = &v
 	}
-	if !orgSettings.CreatorRoleID.IsNull() && !orgSettings.CreatorRoleID.IsUnknown() {
-		v := orgSettings.CreatorRoleID.ValueString()
-		params.CreatorRoleID = &v
+	roles := &roleResolver{client: r.client, appID: appID, env: env}
+	creatorRolePath := path.Root("organization_settings").AtName("creator_role")
+	params.CreatorRoleID = roles.configuredRoleID(ctx, orgSettings.CreatorRole, orgSettings.CreatorRoleID, creatorRolePath, diags)
+	if diags.HasError() {
+		return
 	}
 	if !orgSettings.AdminDeleteEnabled.IsNull() && !orgSettings.AdminDeleteEnabled.IsUnknown() {
 		v := orgSettings.AdminDeleteEnabled.ValueBool()
@@ -558,14 +661,24 @@ func (r *EnvironmentResource) applyOrganizationSettings(ctx context.Context, app
 		}
 		params.DomainsEnrollmentModes = &modes
 	}
-	if !orgSettings.DomainsDefaultRoleID.IsNull() && !orgSettings.DomainsDefaultRoleID.IsUnknown() {
-		v := orgSettings.DomainsDefaultRoleID.ValueString()
-		params.DomainsDefaultRoleID = &v
+	domainsDefaultRolePath := path.Root("organization_settings").AtName("domains_default_role")
+	params.DomainsDefaultRoleID = roles.configuredRoleID(ctx, orgSettings.DomainsDefaultRole, orgSettings.DomainsDefaultRoleID, domainsDefaultRolePath, diags)
+	if diags.HasError() {
+		return
+	}
+
+	// Role errors belong to the attribute configuredRoleID took the role from.
+	paramPaths := maps.Clone(orgSettingsParamPaths)
+	if orgSettings.CreatorRoleID.IsNull() || orgSettings.CreatorRoleID.IsUnknown() {
+		paramPaths["creator_role_id"] = creatorRolePath
+	}
+	if orgSettings.DomainsDefaultRoleID.IsNull() || orgSettings.DomainsDefaultRoleID.IsUnknown() {
+		paramPaths["domains_default_role_id"] = domainsDefaultRolePath
 	}
 
 	result, err := r.client.UpdateOrganizationSettings(ctx, appID, env, params)
 	if err != nil {
-		diags.AddError("Error updating organization settings", err.Error())
+		diagnostics.AddAPIError(diags, "Error updating organization settings", err, paramPaths)
 		return
 	}
 
@@ -577,21 +690,123 @@ func (r *EnvironmentResource) applyOrganizationSettings(ctx context.Context, app
 	}
 
 	// The API returns role keys (e.g. "org:admin") in creator_role / domains_default_role,
-	// but the params accept role IDs via creator_role_id / domains_default_role_id.
-	// To avoid state drift, preserve the user's configured values for these fields
-	// rather than overwriting with the differently-formatted API response.
-	creatorRoleID := orgSettings.CreatorRoleID
-	domainsDefaultRoleID := orgSettings.DomainsDefaultRoleID
+	// but the params accept role IDs. Resolve the returned keys through the roles list
+	// so that state holds both forms.
+	creatorRole, creatorRoleID, creatorRoleKey := roles.roleState(ctx, result.CreatorRole, orgSettings.CreatorRole, orgSettings.CreatorRoleID, creatorRolePath, diags)
+	domainsDefaultRole, domainsDefaultRoleID, domainsDefaultRoleKey := roles.roleState(ctx, result.DomainsDefaultRole, orgSettings.DomainsDefaultRole, orgSettings.DomainsDefaultRoleID, domainsDefaultRolePath, diags)
+	if diags.HasError() {
+		return
+	}
 
 	orgObj, d := types.ObjectValueFrom(ctx, orgSettingsAttrTypes, &OrganizationSettingsModel{
 		Enabled:                types.BoolValue(result.Enabled),
 		MaxAllowedMemberships:  types.Int64Value(result.MaxAllowedMemberships),
+		CreatorRole:            creatorRole,
 		CreatorRoleID:          creatorRoleID,
+		CreatorRoleKey:         creatorRoleKey,
 		AdminDeleteEnabled:     types.BoolValue(result.AdminDeleteEnabled),
 		DomainsEnabled:         types.BoolValue(result.DomainsEnabled),
 		DomainsEnrollmentModes: enrollmentModes,
+		DomainsDefaultRole:     domainsDefaultRole,
 		DomainsDefaultRoleID:   domainsDefaultRoleID,
+		DomainsDefaultRoleKey:  domainsDefaultRoleKey,
 	})
 	diags.Append(d...)
 	plan.OrganizationSettings = orgObj
 }
+
+// roleResolver translates between organization role keys and role IDs for a
+// single instance. The roles list is fetched lazily, at most once per apply.
+type roleResolver struct {
+	client *client.ClerkClient
+	appID  string
+	env    string
+	roles  []*clerk.OrganizationRole
+	loaded bool
+}
+
+// find returns the role matching keyOrID, or nil if the instance has no such role.
+func (rr *roleResolver) find(ctx context.Context, keyOrID string) (*clerk.OrganizationRole, error) {
+	if !rr.loaded {
+		roles, err := rr.client.ListOrganizationRoles(ctx, rr.appID, rr.env)
+		if err != nil {
+			return nil, err
+		}
+		rr.roles = roles
+		rr.loaded = true
+	}
+	return client.FindOrganizationRole(rr.roles, keyOrID), nil
+}
+
+// configuredRoleID returns the role ID to send to the API. The role may be
+// configured either as a key or ID (keyOrID) or directly as an ID (id).
+func (rr *roleResolver) configuredRoleID(ctx context.Context, keyOrID, id types.String, attrPath path.Path, diags *diag.Diagnostics) *string {
+	if !id.IsNull() && !id.IsUnknown() {
+		v := id.ValueString()
+		return &v
+	}
+	if keyOrID.IsNull() || keyOrID.IsUnknown() {
+		return nil
+	}
+
+	role, err := rr.find(ctx, keyOrID.ValueString())
+	if err != nil {
+		diags.AddAttributeError(attrPath, "Error listing organization roles", err.Error())
+		return nil
+	}
+	if role == nil {
+		diags.AddAttributeError(
+			attrPath,
+			"Unknown organization role",
+			fmt.Sprintf("No organization role with key or ID %q exists in %s/%s.", keyOrID.ValueString(), rr.appID, rr.env),
+		)
+		return nil
+	}
+	return &role.ID
+}
+
+// roleState converts the role key returned by the API into the key-or-ID, ID
+// and key values stored in state. Configured values are kept verbatim when they
+// refer to the returned role, so a role configured by ID compares equal to its key.
+// The roles list is only consulted when the role is configured; otherwise the
+// ID is left null so that applies do not depend on listing roles.
+func (rr *roleResolver) roleState(ctx context.Context, apiKey string, keyOrID, id types.String, attrPath path.Path, diags *diag.Diagnostics) (types.String, types.String, types.String) {
+	stateKeyOrID := types.StringNull()
+	stateID := types.StringNull()
+	stateKey := types.StringNull()
+
+	configured := (!keyOrID.IsNull() && !keyOrID.IsUnknown()) || (!id.IsNull() && !id.IsUnknown())
+
+	var role *clerk.OrganizationRole
+	if apiKey != "" {
+		stateKeyOrID = types.StringValue(apiKey)
+		stateKey = types.StringValue(apiKey)
+		if configured {
+			var err error
+			role, err = rr.find(ctx, apiKey)
+			if err != nil {
+				diags.AddAttributeError(attrPath, "Error listing organization roles", err.Error())
+				return types.StringNull(), types.StringNull(), types.StringNull()
+			}
+			if role != nil {
+				stateID = types.StringValue(role.ID)
+			}
+		}
+	}
+
+	// Keep configured values that name the returned role. When the role isn't in
+	// the roles list there is nothing to compare against, so trust the configuration.
+	keep := func(v types.String) bool {
+		if v.IsNull() || v.IsUnknown() {
+			return false
+		}
+		return role == nil || v.ValueString() == role.Key || v.ValueString() == role.ID
+	}
+	if keep(keyOrID) {
+		stateKeyOrID = keyOrID
+	}
+	if keep(id) {
+		stateID = id
+	}
+	return stateKeyOrID, stateID, stateKey
+}
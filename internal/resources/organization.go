@@ -2,26 +2,36 @@ package resources
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/clerk/clerk-sdk-go/v2"
 	"github.com/clerk/clerk-sdk-go/v2/organization"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/makolabsai/terraform-provider-clerk/internal/client"
+	"github.com/makolabsai/terraform-provider-clerk/internal/diagnostics"
 )
 
 var (
 	_ resource.Resource                = (*OrganizationResource)(nil)
 	_ resource.ResourceWithImportState = (*OrganizationResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*OrganizationResource)(nil)
 )
 
 // OrganizationResource manages a Clerk organization via the Backend API.
@@ -31,15 +41,40 @@ type OrganizationResource struct {
 
 // OrganizationResourceModel describes the Terraform resource data model.
 type OrganizationResourceModel struct {
-	ID                    types.String `tfsdk:"id"`
-	ApplicationID         types.String `tfsdk:"application_id"`
-	Environment           types.String `tfsdk:"environment"`
-	Name                  types.String `tfsdk:"name"`
-	Slug                  types.String `tfsdk:"slug"`
-	MaxAllowedMemberships types.Int64  `tfsdk:"max_allowed_memberships"`
-	AdminDeleteEnabled    types.Bool   `tfsdk:"admin_delete_enabled"`
-	CreatedAt             types.Int64  `tfsdk:"created_at"`
-	UpdatedAt             types.Int64  `tfsdk:"updated_at"`
+	ID                    types.String         `tfsdk:"id"`
+	ApplicationID         types.String         `tfsdk:"application_id"`
+	Environment           types.String         `tfsdk:"environment"`
+	Name                  types.String         `tfsdk:"name"`
+	DeletionProtection    types.Bool           `tfsdk:"deletion_protection"`
+	Slug                  types.String         `tfsdk:"slug"`
+	MaxAllowedMemberships types.Int64          `tfsdk:"max_allowed_memberships"`
+	AdminDeleteEnabled    types.Bool           `tfsdk:"admin_delete_enabled"`
+	PublicMetadata        jsontypes.Normalized `tfsdk:"public_metadata"`
+	PrivateMetadata       jsontypes.Normalized `tfsdk:"private_metadata"`
+	CreatedBy             types.String         `tfsdk:"created_by"`
+	LogoPath              types.String         `tfsdk:"logo_path"`
+	LogoSourceURL         types.String         `tfsdk:"logo_source_url"`
+	LogoSHA256            types.String         `tfsdk:"logo_sha256"`
+	ImageURL              types.String         `tfsdk:"image_url"`
+	HasImage              types.Bool           `tfsdk:"has_image"`
+	MembersCount          types.Int64          `tfsdk:"members_count"`
+	CreatedAt             types.Int64          `tfsdk:"created_at"`
+	UpdatedAt             types.Int64          `tfsdk:"updated_at"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// organizationParamPaths maps Backend API request parameters to schema attributes
+// so that API validation errors are reported against the right attribute.
+var organizationParamPaths = map[string]path.Path{
+	"name":                    path.Root("name"),
+	"slug":                    path.Root("slug"),
+	"max_allowed_memberships": path.Root("max_allowed_memberships"),
+	"admin_delete_enabled":    path.Root("admin_delete_enabled"),
+	"public_metadata":         path.Root("public_metadata"),
+	"private_metadata":        path.Root("private_metadata"),
+	"created_by":              path.Root("created_by"),
+	"file":                    path.Root("logo_path"),
 }
 
 func NewOrganizationResource() resource.Resource {
@@ -50,7 +85,7 @@ func (r *OrganizationResource) Metadata(_ context.Context, req resource.Metadata
 	resp.TypeName = req.ProviderTypeName + "_organization"
 }
 
-func (r *OrganizationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *OrganizationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a Clerk organization within a specific application environment. " +
 			"Organizations represent tenants or teams that group users together.",
@@ -70,10 +105,10 @@ func (r *OrganizationResource) Schema(_ context.Context, _ resource.SchemaReques
 				},
 			},
 			"environment": schema.StringAttribute{
-				Description: "The environment type: \"development\" or \"production\".",
+				Description: "The environment type, e.g. \"development\", \"staging\" or \"production\".",
 				Required:    true,
 				Validators: []validator.String{
-					stringvalidator.OneOf("development", "production"),
+					stringvalidator.LengthAtLeast(1),
 				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
@@ -83,6 +118,15 @@ func (r *OrganizationResource) Schema(_ context.Context, _ resource.SchemaReques
 				Description: "The name of the organization.",
 				Required:    true,
 			},
+			"deletion_protection": schema.BoolAttribute{
+				Description: "Whether deletion protection is enabled. When true, the organization cannot be destroyed or replaced. " +
+					"Set to false and apply before destroying. Defaults to true.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"slug": schema.StringAttribute{
 				Description: "URL-friendly identifier for the organization. Auto-generated from name if not provided.",
 				Optional:    true,
@@ -103,6 +147,68 @@ func (r *OrganizationResource) Schema(_ context.Context, _ resource.SchemaReques
 				Description: "Whether organization admins can delete the organization.",
 				Optional:    true,
 				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"public_metadata": schema.StringAttribute{
+				Description: "Metadata visible to the organization's members, as a JSON object. " +
+					"Formatting and key order differences are ignored. Left unchanged when not configured.",
+				CustomType: jsontypes.NormalizedType{},
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"private_metadata": schema.StringAttribute{
+				Description: "Metadata only visible to the Backend API, as a JSON object. " +
+					"Formatting and key order differences are ignored. Left unchanged when not configured.",
+				CustomType: jsontypes.NormalizedType{},
+				Optional:   true,
+				Computed:   true,
+				Sensitive:  true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_by": schema.StringAttribute{
+				Description: "ID of the user who creates the organization and becomes its first admin. Only set at creation time.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"logo_path": schema.StringAttribute{
+				Description: "Path to a local image file to upload as the organization's logo. " +
+					"A change in the file contents uploads the logo again in place. Conflicts with logo_source_url.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("logo_source_url")),
+				},
+			},
+			"logo_source_url": schema.StringAttribute{
+				Description: "URL of an image that is downloaded during apply and uploaded as the organization's logo. " +
+					"The logo is only uploaded again when the URL changes. Conflicts with logo_path.",
+				Optional: true,
+			},
+			"logo_sha256": schema.StringAttribute{
+				Description: "SHA-256 checksum of the uploaded logo contents.",
+				Computed:    true,
+			},
+			"image_url": schema.StringAttribute{
+				Description: "The URL of the organization's logo as hosted by Clerk.",
+				Computed:    true,
+			},
+			"has_image": schema.BoolAttribute{
+				Description: "Whether the organization has a logo.",
+				Computed:    true,
+			},
+			"members_count": schema.Int64Attribute{
+				Description: "The number of members of the organization.",
+				Computed:    true,
 			},
 			"created_at": schema.Int64Attribute{
 				Description: "Unix timestamp of when the organization was created.",
@@ -116,6 +222,14 @@ func (r *OrganizationResource) Schema(_ context.Context, _ resource.SchemaReques
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -143,6 +257,14 @@ func (r *OrganizationResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	name := plan.Name.ValueString()
 	params := &organization.CreateParams{
 		Name: &name,
@@ -156,17 +278,56 @@ func (r *OrganizationResource) Create(ctx context.Context, req resource.CreateRe
 		v := plan.MaxAllowedMemberships.ValueInt64()
 		params.MaxAllowedMemberships = &v
 	}
+	if !plan.CreatedBy.IsNull() && !plan.CreatedBy.IsUnknown() {
+		createdBy := plan.CreatedBy.ValueString()
+		params.CreatedBy = &createdBy
+	}
+	params.PublicMetadata = metadataParam(plan.PublicMetadata)
+	params.PrivateMetadata = metadataParam(plan.PrivateMetadata)
+
+	// Read the logo before creating anything so that a bad path or URL
+	// does not leave an organization behind.
+	logo := r.readLogo(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	appID := plan.ApplicationID.ValueString()
 	env := plan.Environment.ValueString()
 
 	org, err := r.client.CreateOrganization(ctx, appID, env, params)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Clerk organization", err.Error())
+		diagnostics.AddAPIError(&resp.Diagnostics, "Error creating Clerk organization", err, organizationParamPaths)
 		return
 	}
 
+	if plan.DeletionProtection.IsNull() || plan.DeletionProtection.IsUnknown() {
+		plan.DeletionProtection = types.BoolValue(true)
+	}
+
+	// Map the organization before the follow-up calls so that a failure
+	// still records it in state.
+	adminDeleteEnabled := plan.AdminDeleteEnabled
 	mapOrganizationToState(org, &plan)
+
+	// The create endpoint does not accept admin_delete_enabled.
+	if !adminDeleteEnabled.IsNull() && !adminDeleteEnabled.IsUnknown() {
+		v := adminDeleteEnabled.ValueBool()
+		if org.AdminDeleteEnabled != v {
+			_, err := r.client.UpdateOrganization(ctx, appID, env, org.ID, &organization.UpdateParams{
+				AdminDeleteEnabled: &v,
+			})
+			if err != nil {
+				diagnostics.AddAPIError(&resp.Diagnostics, "Error updating Clerk organization after create", err, organizationParamPaths)
+			}
+		}
+	}
+	plan.LogoSHA256 = types.StringNull()
+	if logo != nil && !resp.Diagnostics.HasError() {
+		r.uploadLogo(ctx, &plan, logo, &resp.Diagnostics)
+	}
+
+	r.refresh(ctx, &plan, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -177,30 +338,63 @@ func (r *OrganizationResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	appID := state.ApplicationID.ValueString()
 	env := state.Environment.ValueString()
 
 	org, err := r.client.GetOrganization(ctx, appID, env, state.ID.ValueString())
 	if err != nil {
-		if apiErr, ok := err.(*clerk.APIErrorResponse); ok && apiErr.HTTPStatusCode == 404 {
+		if client.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Error reading Clerk organization", err.Error())
+		diagnostics.AddAPIError(&resp.Diagnostics, "Error reading Clerk organization", err, nil)
 		return
 	}
 
+	// Imported organizations have no deletion_protection value yet.
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(true)
+	}
+
+	// A logo removed outside Terraform is uploaded again on the next apply.
+	if !org.HasImage {
+		state.LogoPath = types.StringNull()
+		state.LogoSourceURL = types.StringNull()
+		state.LogoSHA256 = types.StringNull()
+	}
+
 	mapOrganizationToState(org, &state)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *OrganizationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan OrganizationResourceModel
+	var plan, state OrganizationResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	if plan.DeletionProtection.IsNull() || plan.DeletionProtection.IsUnknown() {
+		plan.DeletionProtection = types.BoolValue(true)
+	}
+
 	params := &organization.UpdateParams{}
 
 	name := plan.Name.ValueString()
@@ -218,17 +412,41 @@ func (r *OrganizationResource) Update(ctx context.Context, req resource.UpdateRe
 		v := plan.AdminDeleteEnabled.ValueBool()
 		params.AdminDeleteEnabled = &v
 	}
+	params.PublicMetadata = metadataParam(plan.PublicMetadata)
+	params.PrivateMetadata = metadataParam(plan.PrivateMetadata)
 
 	appID := plan.ApplicationID.ValueString()
 	env := plan.Environment.ValueString()
 
-	org, err := r.client.UpdateOrganization(ctx, appID, env, plan.ID.ValueString(), params)
+	_, err := r.client.UpdateOrganization(ctx, appID, env, plan.ID.ValueString(), params)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating Clerk organization", err.Error())
+		diagnostics.AddAPIError(&resp.Diagnostics, "Error updating Clerk organization", err, organizationParamPaths)
 		return
 	}
 
-	mapOrganizationToState(org, &plan)
+	logoChanged := !plan.LogoPath.Equal(state.LogoPath) ||
+		!plan.LogoSourceURL.Equal(state.LogoSourceURL) ||
+		!plan.LogoSHA256.Equal(state.LogoSHA256)
+	if logoChanged {
+		if plan.LogoPath.IsNull() && plan.LogoSourceURL.IsNull() {
+			if _, err := r.client.DeleteOrganizationLogo(ctx, appID, env, plan.ID.ValueString()); err != nil {
+				diagnostics.AddAPIError(&resp.Diagnostics, "Error deleting Clerk organization logo", err, nil)
+				return
+			}
+			plan.LogoSHA256 = types.StringNull()
+		} else {
+			logo := r.readLogo(ctx, plan, &resp.Diagnostics)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			r.uploadLogo(ctx, &plan, logo, &resp.Diagnostics)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	r.refresh(ctx, &plan, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -239,16 +457,84 @@ func (r *OrganizationResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	if deletionProtected(state) {
+		resp.Diagnostics.AddError(
+			"Cannot destroy organization with deletion protection enabled",
+			fmt.Sprintf("Organization %q (%s) has deletion_protection = true. "+
+				"Set deletion_protection = false and apply before destroying.",
+				state.Name.ValueString(), state.ID.ValueString()),
+		)
+		return
+	}
+
 	appID := state.ApplicationID.ValueString()
 	env := state.Environment.ValueString()
 
 	_, err := r.client.DeleteOrganization(ctx, appID, env, state.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Error deleting Clerk organization", err.Error())
+		diagnostics.AddAPIError(&resp.Diagnostics, "Error deleting Clerk organization", err, nil)
 		return
 	}
 }
 
+// ModifyPlan plans the checksum of the configured logo, so that changed
+// contents are uploaded in place, and reports at plan time what Delete would
+// refuse: destroying or replacing an organization whose deletion protection
+// is enabled in state.
+func (r *OrganizationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() {
+		r.planLogoChecksum(ctx, req, resp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state OrganizationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || !deletionProtected(state) {
+		return
+	}
+
+	// Attribute-level RequiresReplace results are not passed to ModifyPlan,
+	// so check the attributes that force replacement directly.
+	destroy := req.Plan.Raw.IsNull()
+	if !destroy {
+		var plan OrganizationResourceModel
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if plan.ApplicationID.Equal(state.ApplicationID) &&
+			plan.Environment.Equal(state.Environment) &&
+			plan.CreatedBy.Equal(state.CreatedBy) {
+			return
+		}
+	}
+
+	action := "destroyed"
+	if !destroy {
+		action = "replaced"
+	}
+	resp.Diagnostics.AddError(
+		"Cannot destroy organization with deletion protection enabled",
+		fmt.Sprintf("Organization %q (%s) has deletion_protection = true and would be %s. "+
+			"Set deletion_protection = false and apply before destroying.",
+			state.Name.ValueString(), state.ID.ValueString(), action),
+	)
+}
+
 func (r *OrganizationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Expected format: {application_id}/{environment}/{organization_id}
 	parts := strings.SplitN(req.ID, "/", 3)
@@ -260,19 +546,114 @@ func (r *OrganizationResource) ImportState(ctx context.Context, req resource.Imp
 		return
 	}
 
-	if parts[1] != "development" && parts[1] != "production" {
-		resp.Diagnostics.AddError(
-			"Invalid Environment",
-			fmt.Sprintf("Environment must be \"development\" or \"production\", got: %q", parts[1]),
-		)
-		return
-	}
-
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[2])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_id"), parts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment"), parts[1])...)
 }
 
+// deletionProtected reports whether state forbids deleting the organization.
+// A missing value, e.g. before the first refresh after import, counts as enabled.
+func deletionProtected(state OrganizationResourceModel) bool {
+	return state.DeletionProtection.IsNull() ||
+		state.DeletionProtection.IsUnknown() ||
+		state.DeletionProtection.ValueBool()
+}
+
+// readLogo returns the contents of the configured logo_path or logo_source_url, or
+// nil if neither is set.
+func (r *OrganizationResource) readLogo(ctx context.Context, plan OrganizationResourceModel, diags *diag.Diagnostics) []byte {
+	switch {
+	case !plan.LogoPath.IsNull():
+		logo, err := os.ReadFile(plan.LogoPath.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("logo_path"), "Error reading organization logo", err.Error())
+			return nil
+		}
+		return logo
+	case !plan.LogoSourceURL.IsNull():
+		logo, err := r.client.Download(ctx, plan.LogoSourceURL.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("logo_source_url"), "Error downloading organization logo", err.Error())
+			return nil
+		}
+		return logo
+	default:
+		return nil
+	}
+}
+
+// planLogoChecksum sets logo_sha256 in the plan without network access. For
+// logo_path it is the checksum of the local file, left unknown when the file
+// cannot be read yet, e.g. because it is created during apply. For
+// logo_source_url the prior checksum is kept while the URL is unchanged, and
+// left unknown otherwise; the image is only downloaded during apply.
+func (r *OrganizationResource) planLogoChecksum(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan OrganizationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checksum := types.StringUnknown()
+	switch {
+	case plan.LogoPath.IsNull() && plan.LogoSourceURL.IsNull():
+		checksum = types.StringNull()
+	case plan.LogoPath.IsUnknown() || plan.LogoSourceURL.IsUnknown():
+	case !plan.LogoPath.IsNull():
+		if logo, err := os.ReadFile(plan.LogoPath.ValueString()); err == nil {
+			checksum = types.StringValue(logoChecksum(logo))
+		}
+	case !req.State.Raw.IsNull():
+		var state OrganizationResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if plan.LogoSourceURL.Equal(state.LogoSourceURL) {
+			checksum = state.LogoSHA256
+		}
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("logo_sha256"), checksum)...)
+}
+
+// uploadLogo uploads logo as the logo of the organization in state and
+// records its checksum.
+func (r *OrganizationResource) uploadLogo(ctx context.Context, state *OrganizationResourceModel, logo []byte, diags *diag.Diagnostics) {
+	_, err := r.client.UpdateOrganizationLogo(ctx, state.ApplicationID.ValueString(), state.Environment.ValueString(), state.ID.ValueString(), logo)
+	if err != nil {
+		diagnostics.AddAPIError(diags, "Error uploading Clerk organization logo", err, organizationParamPaths)
+		return
+	}
+	state.LogoSHA256 = types.StringValue(logoChecksum(logo))
+}
+
+// logoChecksum returns the hex-encoded SHA-256 checksum of logo.
+func logoChecksum(logo []byte) string {
+	sum := sha256.Sum256(logo)
+	return hex.EncodeToString(sum[:])
+}
+
+// refresh re-reads the organization after create or update so that state
+// includes the members count, which mutation responses omit.
+func (r *OrganizationResource) refresh(ctx context.Context, state *OrganizationResourceModel, diags *diag.Diagnostics) {
+	org, err := r.client.GetOrganization(ctx, state.ApplicationID.ValueString(), state.Environment.ValueString(), state.ID.ValueString())
+	if err != nil {
+		diagnostics.AddAPIError(diags, "Error reading Clerk organization", err, nil)
+		return
+	}
+	mapOrganizationToState(org, state)
+}
+
+// metadataParam returns configured metadata as a request parameter, or nil to
+// leave the organization's metadata unchanged.
+func metadataParam(value jsontypes.Normalized) *json.RawMessage {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	raw := json.RawMessage(value.ValueString())
+	return &raw
+}
+
 // mapOrganizationToState maps a Clerk Organization API response to the Terraform model.
 func mapOrganizationToState(org *clerk.Organization, state *OrganizationResourceModel) {
 	state.ID = types.StringValue(org.ID)
@@ -280,6 +661,15 @@ func mapOrganizationToState(org *clerk.Organization, state *OrganizationResource
 	state.Slug = types.StringValue(org.Slug)
 	state.MaxAllowedMemberships = types.Int64Value(org.MaxAllowedMemberships)
 	state.AdminDeleteEnabled = types.BoolValue(org.AdminDeleteEnabled)
+	state.PublicMetadata = jsontypes.NewNormalizedValue(client.MetadataJSON(org.PublicMetadata))
+	state.PrivateMetadata = jsontypes.NewNormalizedValue(client.MetadataJSON(org.PrivateMetadata))
+	state.CreatedBy = types.StringNull()
+	if org.CreatedBy != "" {
+		state.CreatedBy = types.StringValue(org.CreatedBy)
+	}
+	state.ImageURL = types.StringPointerValue(org.ImageURL)
+	state.HasImage = types.BoolValue(org.HasImage)
+	state.MembersCount = types.Int64PointerValue(org.MembersCount)
 	state.CreatedAt = types.Int64Value(org.CreatedAt)
 	state.UpdatedAt = types.Int64Value(org.UpdatedAt)
 }
@@ -0,0 +1,10 @@
+package resources
+
+import (
+	"time"
+)
+
+// defaultTimeout bounds each resource operation when no timeouts block is
+// configured. It covers every request the operation makes, including retries
+// and rate limiting waits.
+const defaultTimeout = 20 * time.Minute
@@ -0,0 +1,42 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = positiveDurationValidator{}
+
+// positiveDurationValidator checks that a string is a positive Go duration,
+// so that invalid values are reported at plan time rather than during apply.
+type positiveDurationValidator struct{}
+
+// positiveDuration returns a validator for positive Go duration strings such as "30s".
+func positiveDuration() validator.String {
+	return positiveDurationValidator{}
+}
+
+func (v positiveDurationValidator) Description(_ context.Context) string {
+	return "value must be a positive duration such as \"30s\" or \"5m\""
+}
+
+func (v positiveDurationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v positiveDurationValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if d, err := time.ParseDuration(req.ConfigValue.ValueString()); err != nil || d <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("Expected a positive duration such as \"30s\", got: %q", req.ConfigValue.ValueString()),
+		)
+	}
+}